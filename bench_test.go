@@ -0,0 +1,393 @@
+package goulist
+
+import (
+	"container/list"
+	"container/ring"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"testing"
+)
+
+// benchLen is number of elements of lists used by benchmarks.
+const benchLen = 10000
+
+// benchCaps are node capacities compared by benchmarks.
+var benchCaps = []int{4, 16, CacheLineSize, 256, 1024}
+
+// benchRing creates ring of n elements.
+func benchRing(n int) *ring.Ring {
+	r := ring.New(n)
+
+	for i := 0; i < n; i++ {
+		r.Value = i
+		r = r.Next()
+	}
+
+	return r
+}
+
+// benchContainerList creates container/list of n elements.
+func benchContainerList(n int) *list.List {
+	l := list.New()
+
+	for i := 0; i < n; i++ {
+		l.PushBack(i)
+	}
+
+	return l
+}
+
+// benchIndexes returns n random indexes in range [0, max).
+func benchIndexes(n, max int) []int {
+	rnd := rand.New(rand.NewSource(1))
+	idx := make([]int, n)
+
+	for i := range idx {
+		idx[i] = rnd.Intn(max)
+	}
+
+	return idx
+}
+
+func BenchmarkPush(b *testing.B) {
+	for _, c := range benchCaps {
+		b.Run(fmt.Sprintf("ulist/cap=%d", c), func(b *testing.B) {
+			b.ReportAllocs()
+
+			ul := NewListCustomCap[int](c)
+
+			for i := 0; i < b.N; i++ {
+				ul.Push(i)
+			}
+		})
+	}
+
+	b.Run("slice", func(b *testing.B) {
+		b.ReportAllocs()
+
+		s := []int{}
+
+		for i := 0; i < b.N; i++ {
+			s = append(s, i)
+		}
+	})
+
+	b.Run("list", func(b *testing.B) {
+		b.ReportAllocs()
+
+		l := list.New()
+
+		for i := 0; i < b.N; i++ {
+			l.PushBack(i)
+		}
+	})
+
+	b.Run("ring", func(b *testing.B) {
+		b.ReportAllocs()
+
+		r := ring.New(1)
+
+		for i := 0; i < b.N; i++ {
+			r.Link(&ring.Ring{Value: i})
+			r = r.Next()
+		}
+	})
+}
+
+func BenchmarkGetRandom(b *testing.B) {
+	idx := benchIndexes(1024, benchLen)
+
+	for _, c := range benchCaps {
+		b.Run(fmt.Sprintf("ulist/cap=%d", c), func(b *testing.B) {
+			ul := newRangeList(c, 0, benchLen)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				ul.At(idx[i%len(idx)])
+			}
+		})
+	}
+
+	b.Run("slice", func(b *testing.B) {
+		s := newRangeList(benchLen, 0, benchLen).ExportElems()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			_ = s[idx[i%len(idx)]]
+		}
+	})
+
+	b.Run("list", func(b *testing.B) {
+		l := benchContainerList(benchLen)
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			e := l.Front()
+
+			for j := idx[i%len(idx)]; j > 0; j-- {
+				e = e.Next()
+			}
+		}
+	})
+
+	b.Run("ring", func(b *testing.B) {
+		r := benchRing(benchLen)
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			r.Move(idx[i%len(idx)])
+		}
+	})
+}
+
+func BenchmarkInsertMiddle(b *testing.B) {
+	for _, c := range benchCaps {
+		b.Run(fmt.Sprintf("ulist/cap=%d", c), func(b *testing.B) {
+			b.ReportAllocs()
+
+			ul := newRangeList(c, 0, benchLen)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				ul.InsertAt(ul.Len()/2, i)
+			}
+		})
+	}
+
+	b.Run("slice", func(b *testing.B) {
+		b.ReportAllocs()
+
+		s := newRangeList(benchLen, 0, benchLen).ExportElems()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			m := len(s) / 2
+			s = append(s, 0)
+			copy(s[m+1:], s[m:])
+			s[m] = i
+		}
+	})
+
+	b.Run("list", func(b *testing.B) {
+		b.ReportAllocs()
+
+		l := benchContainerList(benchLen)
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			e := l.Front()
+
+			for j := l.Len() / 2; j > 0; j-- {
+				e = e.Next()
+			}
+
+			l.InsertBefore(i, e)
+		}
+	})
+
+	b.Run("ring", func(b *testing.B) {
+		b.ReportAllocs()
+
+		var (
+			r = benchRing(benchLen)
+			n = benchLen
+		)
+
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			r.Move(n / 2).Link(&ring.Ring{Value: i})
+			n++
+		}
+	})
+}
+
+func BenchmarkDeleteMiddle(b *testing.B) {
+	for _, c := range benchCaps {
+		b.Run(fmt.Sprintf("ulist/cap=%d", c), func(b *testing.B) {
+			ul := newRangeList(c, 0, benchLen)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if ul.Len() == 0 {
+					b.StopTimer()
+					ul = newRangeList(c, 0, benchLen)
+					b.StartTimer()
+				}
+
+				ul.RemoveAt(ul.Len() / 2)
+			}
+		})
+	}
+
+	b.Run("slice", func(b *testing.B) {
+		s := newRangeList(benchLen, 0, benchLen).ExportElems()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			if len(s) == 0 {
+				b.StopTimer()
+				s = newRangeList(benchLen, 0, benchLen).ExportElems()
+				b.StartTimer()
+			}
+
+			m := len(s) / 2
+			s = append(s[:m], s[m+1:]...)
+		}
+	})
+
+	b.Run("list", func(b *testing.B) {
+		l := benchContainerList(benchLen)
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			if l.Len() == 0 {
+				b.StopTimer()
+				l = benchContainerList(benchLen)
+				b.StartTimer()
+			}
+
+			e := l.Front()
+
+			for j := l.Len() / 2; j > 0; j-- {
+				e = e.Next()
+			}
+
+			l.Remove(e)
+		}
+	})
+
+	b.Run("ring", func(b *testing.B) {
+		var (
+			r = benchRing(benchLen)
+			n = benchLen
+		)
+
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			if n == 1 {
+				b.StopTimer()
+				r, n = benchRing(benchLen), benchLen
+				b.StartTimer()
+			}
+
+			r.Move(n/2 - 1).Unlink(1)
+			n--
+		}
+	})
+}
+
+func BenchmarkIterate(b *testing.B) {
+	for _, c := range benchCaps {
+		b.Run(fmt.Sprintf("ulist/cap=%d", c), func(b *testing.B) {
+			ul := newRangeList(c, 0, benchLen)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				sum := 0
+
+				for v := range ul.Values() {
+					sum += v
+				}
+			}
+		})
+	}
+
+	b.Run("slice", func(b *testing.B) {
+		s := newRangeList(benchLen, 0, benchLen).ExportElems()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			sum := 0
+
+			for _, v := range s {
+				sum += v
+			}
+		}
+	})
+
+	b.Run("list", func(b *testing.B) {
+		l := benchContainerList(benchLen)
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			sum := 0
+
+			for e := l.Front(); e != nil; e = e.Next() {
+				sum += e.Value.(int)
+			}
+		}
+	})
+
+	b.Run("ring", func(b *testing.B) {
+		r := benchRing(benchLen)
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			sum := 0
+
+			r.Do(func(v any) {
+				sum += v.(int)
+			})
+		}
+	})
+}
+
+// heapBytes returns number of bytes allocated in the heap for the value
+// returned by fn.
+func heapBytes(fn func() interface{}) uint64 {
+	var before, after runtime.MemStats
+
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	v := fn()
+
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(v)
+
+	return after.HeapAlloc - before.HeapAlloc
+}
+
+// BenchmarkMemory reports heap bytes per element of a container
+// of benchLen elements as "bytes/elem" metric.
+func BenchmarkMemory(b *testing.B) {
+	report := func(b *testing.B, fn func() interface{}) {
+		var total uint64
+
+		for i := 0; i < b.N; i++ {
+			total += heapBytes(fn)
+		}
+
+		b.ReportMetric(float64(total)/float64(b.N)/benchLen, "bytes/elem")
+	}
+
+	for _, c := range benchCaps {
+		b.Run(fmt.Sprintf("ulist/cap=%d", c), func(b *testing.B) {
+			report(b, func() interface{} { return newRangeList(c, 0, benchLen) })
+		})
+	}
+
+	b.Run("slice", func(b *testing.B) {
+		report(b, func() interface{} {
+			s := []int{}
+
+			for i := 0; i < benchLen; i++ {
+				s = append(s, i)
+			}
+
+			return s
+		})
+	})
+
+	b.Run("list", func(b *testing.B) {
+		report(b, func() interface{} { return benchContainerList(benchLen) })
+	})
+
+	b.Run("ring", func(b *testing.B) {
+		report(b, func() interface{} { return benchRing(benchLen) })
+	})
+}
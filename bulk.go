@@ -0,0 +1,138 @@
+package goulist
+
+import (
+	"errors"
+)
+
+// addAll appends elements of vals to the end of the node while its size
+// is less than fill. Returns number of added elements.
+func (un *ulistNode[T]) addAll(vals []T, fill int) int {
+	if un.size >= fill {
+		return 0
+	}
+
+	n := copy(un.elems[un.size:fill], vals)
+	un.size += n
+
+	return n
+}
+
+// newChain creates chain of linked nodes with the same capacity and policy
+// as the node proto, filled with elements of vals, fill elements per node.
+// The last node gets the rest of elements. Returns first and last nodes
+// of the chain and number of nodes.
+func newChain[T any](proto *ulistNode[T], vals []T, fill int) (*ulistNode[T], *ulistNode[T], int) {
+	var (
+		first *ulistNode[T]
+		last  *ulistNode[T]
+		n     = 0
+	)
+
+	for len(vals) > 0 {
+		node := proto.newNode()
+		k := node.addAll(vals, fill)
+
+		if last == nil {
+			first = node
+		} else {
+			last.next = node
+			node.prev = last
+		}
+
+		last = node
+		vals = vals[k:]
+		n++
+	}
+
+	return first, last, n
+}
+
+// pushAll appends all of the elements of vals to the end of the list.
+// The last node is filled up to fill elements, then new nodes are created
+// with fill elements each.
+func (ul *List[T]) pushAll(vals []T, fill int) error {
+	var err error
+
+	k := ul.last.addAll(vals, fill)
+	ul.length += k
+
+	if vals = vals[k:]; len(vals) > 0 {
+		first, last, n := newChain(ul.last, vals, fill)
+
+		ul.linkChainAfter(ul.last, first, last, n)
+		ul.length += len(vals)
+	}
+
+	return err
+}
+
+// PushAllFill appends all of the elements of the given slice vals to the end
+// of the list, in the original order, putting fill elements into each new node.
+// Nodes filled less than full leave room for later insertions without splits.
+// Returns error if fill is less than min fill of nodes (half of node's capacity
+// by default) or greater than node's capacity.
+func (ul *List[T]) PushAllFill(vals []T, fill int) error {
+	if fill < ul.last.minFill() || fill < 1 || fill > ul.last.capacity {
+		return errors.New("Fill is out of range")
+	}
+
+	ul.unshare()
+
+	return ul.pushAll(vals, fill)
+}
+
+// InsertAll inserts all of the elements of the given slice vals before element
+// with the given logical index, in the original order. If index is equal
+// to list's length, vals are appended to the end of list. Target node is split
+// once at the index, inserted elements and the rest of the node are packed
+// into full nodes. Returns error if index is out of range.
+func (ul *List[T]) InsertAll(index int, vals []T) error {
+	if index == ul.length {
+		return ul.PushAll(vals)
+	}
+
+	ul.unshare()
+
+	node, i, err := ul.findElem(index)
+
+	if err != nil || len(vals) == 0 {
+		return err
+	}
+
+	var (
+		zero T
+		rest = make([]T, 0, len(vals)+node.size-i)
+		last = node
+	)
+
+	// split the node, its tail goes after inserted elements
+	rest = append(rest, vals...)
+	rest = append(rest, node.elems[i:node.size]...)
+
+	for j := i; j < node.size; j++ {
+		node.elems[j] = zero
+	}
+
+	node.size = i
+
+	k := node.addAll(rest, node.capacity)
+
+	if rest = rest[k:]; len(rest) > 0 {
+		var (
+			first *ulistNode[T]
+			n     int
+		)
+
+		first, last, n = newChain(node, rest, node.capacity)
+
+		ul.linkChainAfter(node, first, last, n)
+	}
+
+	ul.length += len(vals)
+
+	// the last filled node may be less than half-full
+	n := last.redistribAfterDeletion()
+	ul.afterDeletion(last, n)
+
+	return err
+}
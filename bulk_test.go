@@ -0,0 +1,198 @@
+package goulist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func nodeSizes[T any](ul *List[T]) []int {
+	sizes := []int{}
+
+	for node := ul.first; node != nil; node = node.next {
+		sizes = append(sizes, node.size)
+	}
+
+	return sizes
+}
+
+func Test_ulistNode_addAll(t *testing.T) {
+	tests := []struct {
+		name string
+		fill int
+		want int
+	}{
+		{"addAllFullTest", nodeSize, 2},
+		{"addAllFillTest", 3, 1},
+		{"addAllNothingTest", 2, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			un := &ulistNode[interface{}]{nil, nil, 2, nodeSize, []interface{}{1, 2, nil, nil}, nil}
+
+			if got := un.addAll([]interface{}{3, 4, 5}, tt.fill); got != tt.want {
+				t.Errorf("ulistNode.addAll() = %v, want %v", got, tt.want)
+			}
+
+			if un.size != 2+tt.want {
+				t.Errorf("ulistNode.addAll() size = %v, want %v", un.size, 2+tt.want)
+			}
+		})
+	}
+}
+
+func Test_newChain(t *testing.T) {
+	first, last, n := newChain(newUlistNode[int](nodeSize), []int{1, 2, 3, 4, 5, 6, 7}, 3)
+
+	if n != 3 || first.next.next != last || last.prev.prev != first {
+		t.Fatalf("newChain() created broken chain of %d nodes", n)
+	}
+
+	if last.size != 1 || last.elems[0] != 7 || first.size != 3 {
+		t.Errorf("newChain() put wrong elements to nodes")
+	}
+}
+
+func TestList_PushAllBulk(t *testing.T) {
+	ul := NewListCustomCap[int](nodeSize)
+	want := []int{}
+
+	ul.Push(0)
+	want = append(want, 0)
+
+	vals := []int{}
+
+	for i := 1; i < 11; i++ {
+		vals = append(vals, i)
+	}
+
+	if err := ul.PushAll(vals); err != nil {
+		t.Fatalf("List.PushAll() error = %v", err)
+	}
+
+	want = append(want, vals...)
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) || ul.Len() != len(want) {
+		t.Errorf("List.PushAll() = %v, want %v", got, want)
+	}
+
+	if got := nodeSizes(ul); !reflect.DeepEqual(got, []int{4, 4, 3}) {
+		t.Errorf("List.PushAll() node sizes = %v, want fully packed nodes", got)
+	}
+
+	if ul.GetSize() != 3 || ul.last.next != nil {
+		t.Errorf("List.PushAll() broke list's structure")
+	}
+}
+
+func TestList_PushAllFill(t *testing.T) {
+	tests := []struct {
+		name      string
+		fill      int
+		wantSizes []int
+		wantErr   bool
+	}{
+		{"pushAllFillTest", 3, []int{3, 3, 3, 1}, false},
+		{"pushAllFillFullTest", 4, []int{4, 4, 2}, false},
+		{"pushAllFillTooLowTest", 1, []int{0}, true},
+		{"pushAllFillTooHighTest", 5, []int{0}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ul := NewListCustomCap[int](nodeSize)
+
+			err := ul.PushAllFill([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, tt.fill)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("List.PushAllFill() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got := nodeSizes(ul); !reflect.DeepEqual(got, tt.wantSizes) {
+				t.Errorf("List.PushAllFill() node sizes = %v, want %v", got, tt.wantSizes)
+			}
+		})
+	}
+}
+
+func TestList_InsertAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		index   int
+		vals    []int
+		want    []int
+		wantErr bool
+	}{
+		{
+			"insertAllStartTest",
+			0,
+			[]int{-1, -2},
+			[]int{-1, -2, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			false,
+		},
+
+		{
+			"insertAllMiddleTest",
+			5,
+			[]int{-1, -2, -3, -4, -5, -6, -7, -8, -9},
+			[]int{0, 1, 2, 3, 4, -1, -2, -3, -4, -5, -6, -7, -8, -9, 5, 6, 7, 8, 9},
+			false,
+		},
+
+		{
+			"insertAllOneTest",
+			9,
+			[]int{-1},
+			[]int{0, 1, 2, 3, 4, 5, 6, 7, 8, -1, 9},
+			false,
+		},
+
+		{
+			"insertAllEndTest",
+			10,
+			[]int{-1, -2},
+			[]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, -1, -2},
+			false,
+		},
+
+		{
+			"insertAllEmptyTest",
+			3,
+			[]int{},
+			[]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			false,
+		},
+
+		{
+			"insertAllWithErrorTest",
+			11,
+			[]int{-1},
+			[]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ul := newRangeList(nodeSize, 0, 10)
+
+			if err := ul.InsertAll(tt.index, tt.vals); (err != nil) != tt.wantErr {
+				t.Errorf("List.InsertAll() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got := ul.ExportElems(); !reflect.DeepEqual(got, tt.want) || ul.Len() != len(tt.want) {
+				t.Errorf("List.InsertAll() = %v, want %v", got, tt.want)
+			}
+
+			for node := ul.first; node != ul.last; node = node.next {
+				if node.size < nodeSize/2 {
+					t.Errorf("List.InsertAll() left node with %d elements", node.size)
+				}
+			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("List.InsertAll() broke list's structure: %v", err)
+			}
+		})
+	}
+}
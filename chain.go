@@ -0,0 +1,181 @@
+package goulist
+
+import (
+	"errors"
+)
+
+// compatible checks if nodes of the list other can be linked into the list:
+// nodes of both lists must have the same capacity and policy (see
+// samePolicy). Policies are compared by value, since each constructor
+// creates its own options.
+func (ul *List[T]) compatible(other *List[T]) error {
+	if other == ul {
+		return errors.New("List can not be joined with itself")
+	}
+
+	if other.first.capacity != ul.first.capacity || !other.first.samePolicy(ul.first) {
+		return errors.New("Node capacity or policy of lists does not match")
+	}
+
+	return nil
+}
+
+// Concat moves all of the elements of the list other to the end of the list,
+// linking other's chain of nodes after the last node of the list. Only the
+// last node of the list is refilled from the next one if it is filled less
+// than min fill, so it takes O(capacity) time. The list other becomes empty.
+// Returns error if lists have different node capacity or policy, i.e.
+// were created with different options, or other is the list itself. Lists
+// with equality function (see WithEqual) can be joined only if they share
+// options, e.g. other is returned by SplitAt or Clone of the list.
+func (ul *List[T]) Concat(other *List[T]) error {
+	if err := ul.compatible(other); err != nil {
+		return err
+	}
+
+	ul.unshare()
+	other.unshare()
+
+	if other.length == 0 {
+		return nil
+	}
+
+	var (
+		empty = other.first.newNode()
+		last  = ul.last
+	)
+
+	// the first node keeps options of the list, e.g. its encoding flags
+	if ul.length == 0 {
+		first := other.first
+
+		ul.first.size = copy(ul.first.elems, first.elems[:first.size])
+
+		if first.next != nil {
+			ul.linkChainAfter(ul.first, first.next, other.last, other.size-1)
+		}
+	} else {
+		ul.linkChainAfter(last, other.first, other.last, other.size)
+
+		// the former last node may be less than half-full
+		n := last.redistribAfterDeletion()
+		ul.afterDeletion(last, n)
+	}
+
+	ul.length += other.length
+
+	other.first = empty
+	other.last = empty
+	other.size = 1
+	other.length = 0
+
+	return nil
+}
+
+// SplitAt cuts the list before element with the given logical index.
+// The list keeps elements before the index and is returned as the first
+// result, the rest of elements are moved to the new list returned as
+// the second one, with the same node capacity and policy. The node
+// containing the element is split once at the index and the first node
+// of the new list is refilled from the next one if needed, so it takes
+// O(capacity) time plus the walk to the index. If index is equal to list's
+// length, the new list is empty. Returns error if index is out of range.
+func (ul *List[T]) SplitAt(index int) (*List[T], *List[T], error) {
+	if index < 0 || index > ul.length {
+		return ul, nil, errors.New("Element index is out of range")
+	}
+
+	ul.unshare()
+
+	empty := ul.first.newNode()
+	tail := &List[T]{first: empty, last: empty, size: 1}
+
+	if index == ul.length {
+		return ul, tail, nil
+	}
+
+	if index == 0 {
+		tail.first, ul.first = ul.first, empty
+		tail.last, ul.last = ul.last, empty
+		tail.size, ul.size = ul.size, 1
+		tail.length, ul.length = ul.length, 0
+
+		return ul, tail, nil
+	}
+
+	node, i, err := ul.findElem(index)
+
+	if err != nil {
+		return ul, nil, err
+	}
+
+	// split the node, its tail starts the new list
+	if i > 0 {
+		var (
+			zero    T
+			newNode = node.newNode()
+		)
+
+		newNode.size = copy(newNode.elems, node.elems[i:node.size])
+
+		for j := i; j < node.size; j++ {
+			node.elems[j] = zero
+		}
+
+		node.size = i
+
+		ul.linkAfter(node, newNode)
+		node = newNode
+	}
+
+	n := 0
+
+	for x := node; x != nil; x = x.next {
+		n++
+	}
+
+	tail.first = node
+	tail.last = ul.last
+	tail.size = n
+	tail.length = ul.length - index
+
+	ul.last = node.prev
+	ul.last.next = nil
+	node.prev = nil
+	ul.size -= n
+	ul.length = index
+
+	// the first node of the new list may be less than half-full
+	k := node.redistribAfterDeletion()
+	tail.afterDeletion(node, k)
+
+	return ul, tail, nil
+}
+
+// Splice moves all of the elements of the list other into the list before
+// element with the given logical index, linking other's chain of nodes
+// into the list (see SplitAt and Concat). If index is equal to list's
+// length, elements are appended to the end of list. The list other becomes
+// empty. Returns error if index is out of range, or lists can not be joined
+// (see Concat), the lists are not changed in this case.
+func (ul *List[T]) Splice(index int, other *List[T]) error {
+	if index < 0 || index > ul.length {
+		return errors.New("Element index is out of range")
+	}
+
+	if err := ul.compatible(other); err != nil {
+		return err
+	}
+
+	_, tail, err := ul.SplitAt(index)
+
+	if err != nil {
+		return err
+	}
+
+	if err = ul.Concat(other); err != nil {
+		return err
+	}
+
+	return ul.Concat(tail)
+}
@@ -0,0 +1,225 @@
+package goulist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestList_Concat(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		m    int
+	}{
+		{"concatTest", 10, 10},
+		{"concatLastUnderfilledTest", 9, 7},
+		{"concatOtherSmallTest", 9, 1},
+		{"concatEmptyTest", 0, 5},
+		{"concatOtherEmptyTest", 5, 0},
+		{"concatBothEmptyTest", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ul    = newRangeList(nodeSize, 0, tt.n)
+				other = newRangeList(nodeSize, tt.n, tt.n+tt.m, WithMinFill(nodeSize/2))
+			)
+
+			if err := ul.Concat(other); err != nil {
+				t.Fatalf("List.Concat() error = %v", err)
+			}
+
+			if got, want := ul.ExportElems(), intRange(0, tt.n+tt.m); !reflect.DeepEqual(got, want) {
+				t.Errorf("List.Concat() = %v, want %v", got, want)
+			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("List.Concat() broke list's structure: %v", err)
+			}
+
+			if err := other.Validate(); err != nil || other.Len() != 0 {
+				t.Errorf("List.Concat() left %d elements in other list: %v", other.Len(), err)
+			}
+		})
+	}
+}
+
+func TestList_ConcatWithError(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 5)
+
+	tests := []struct {
+		name  string
+		other *List[int]
+	}{
+		{"concatSelfTest", ul},
+		{"concatCapacityTest", NewListCustomCap[int](nodeSize * 2)},
+		{"concatMinFillTest", NewListCustomCap[int](nodeSize, WithMinFill(1))},
+		{"concatAppendSplitTest", NewListCustomCap[int](nodeSize, WithAppendOptimizedSplit())},
+		{"concatEqualTest", NewListCustomCap[int](nodeSize, WithEqual(func(a, b int) bool { return a == b }))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.other.Push(-1)
+
+			if err := ul.Concat(tt.other); err == nil {
+				t.Errorf("List.Concat() error = nil")
+			}
+
+			if err := ul.Splice(0, tt.other); err == nil {
+				t.Errorf("List.Splice() error = nil")
+			}
+		})
+	}
+}
+
+func TestList_SplitAt(t *testing.T) {
+	for index := 0; index <= 13; index++ {
+		ul := newRangeList(nodeSize, 0, 13)
+		ul.InsertAt(5, 5) // leave some nodes less than full
+
+		want := append(intRange(0, 6), intRange(5, 13)...)
+
+		head, tail, err := ul.SplitAt(index)
+
+		if err != nil || head != ul {
+			t.Fatalf("List.SplitAt(%d) error = %v", index, err)
+		}
+
+		if got := head.ExportElems(); !reflect.DeepEqual(got, want[:index]) {
+			t.Errorf("List.SplitAt(%d) head = %v, want %v", index, got, want[:index])
+		}
+
+		if got := tail.ExportElems(); !reflect.DeepEqual(got, want[index:]) {
+			t.Errorf("List.SplitAt(%d) tail = %v, want %v", index, got, want[index:])
+		}
+
+		for _, l := range []*List[int]{head, tail} {
+			if err := l.Validate(); err != nil {
+				t.Errorf("List.SplitAt(%d) broke list's structure: %v", index, err)
+			}
+		}
+	}
+
+	if _, _, err := newRangeList(nodeSize, 0, 3).SplitAt(4); err == nil {
+		t.Errorf("List.SplitAt() error = nil for index out of range")
+	}
+}
+
+func TestList_Splice(t *testing.T) {
+	for index := 0; index <= 10; index++ {
+		var (
+			ul    = newRangeList(nodeSize, 0, 10)
+			other = newRangeList(nodeSize, -5, 0)
+			want  = append(intRange(0, index), append(intRange(-5, 0), intRange(index, 10)...)...)
+		)
+
+		if err := ul.Splice(index, other); err != nil {
+			t.Fatalf("List.Splice(%d) error = %v", index, err)
+		}
+
+		if got := ul.ExportElems(); !reflect.DeepEqual(got, want) {
+			t.Errorf("List.Splice(%d) = %v, want %v", index, got, want)
+		}
+
+		if err := ul.Validate(); err != nil || other.Len() != 0 {
+			t.Errorf("List.Splice(%d) broke list's structure: %v", index, err)
+		}
+	}
+
+	ul := newRangeList(nodeSize, 0, 3)
+
+	if err := ul.Splice(4, newRangeList(nodeSize, 0, 3)); err == nil || ul.Len() != 3 {
+		t.Errorf("List.Splice() error = %v for index out of range", err)
+	}
+}
+
+func TestList_ConcatSnapshot(t *testing.T) {
+	var (
+		ul    = newRangeList(nodeSize, 0, 5)
+		other = newRangeList(nodeSize, 5, 10)
+		snap  = other.Snapshot()
+	)
+
+	ul.Concat(other)
+
+	if got, want := snap.ExportElems(), intRange(5, 10); !reflect.DeepEqual(got, want) {
+		t.Errorf("List.Concat() changed snapshot of other list: %v, want %v", got, want)
+	}
+}
+
+func TestList_ConcatKeepsNodes(t *testing.T) {
+	var (
+		ul    = newRangeList(nodeSize, 0, 8, WithSplitRatio(0.5))
+		other = newRangeList(nodeSize, 8, 100, WithSplitRatio(0.5))
+		tail  = other.last
+		opts  = other.last.opts
+	)
+
+	if err := ul.Concat(other); err != nil {
+		t.Fatalf("List.Concat() error = %v", err)
+	}
+
+	// nodes of other are linked as they are, without a walk over them
+	if ul.last != tail || ul.last.opts != opts || ul.first.opts == opts {
+		t.Errorf("List.Concat() did not link nodes of other list")
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("List.Concat() broke list's structure: %v", err)
+	}
+}
+
+func TestList_ConcatEqual(t *testing.T) {
+	modEqual := func(m int) Option {
+		return WithEqual(func(a, b int) bool { return a%m == b%m })
+	}
+
+	// functions made from one literal share code, but compare differently
+	ul := newRangeList(nodeSize, 0, 5, modEqual(2))
+
+	if err := ul.Concat(newRangeList(nodeSize, 5, 10, modEqual(3))); err == nil {
+		t.Errorf("List.Concat() of lists with different equality error = nil")
+	}
+
+	// parts of one list share its options
+	head, tail, _ := ul.SplitAt(2)
+
+	if err := head.Concat(tail); err != nil {
+		t.Errorf("List.Concat() of split list error = %v", err)
+	}
+
+	if err := head.Validate(); err != nil {
+		t.Errorf("List.Concat() broke list's structure: %v", err)
+	}
+}
+
+func TestList_ConcatJSONLayout(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+	}{
+		{"concatJSONLayoutTest", 5},
+		{"concatEmptyJSONLayoutTest", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ul := newRangeList(nodeSize, 0, tt.n, WithJSONLayout())
+
+			// encoding flags do not change the way nodes are filled
+			if err := ul.Concat(newRangeList(nodeSize, tt.n, 10)); err != nil {
+				t.Fatalf("List.Concat() error = %v", err)
+			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("List.Concat() broke list's structure: %v", err)
+			}
+
+			if !ul.first.opts.jsonLayout {
+				t.Errorf("List.Concat() lost encoding options of the list")
+			}
+		})
+	}
+}
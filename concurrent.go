@@ -0,0 +1,545 @@
+package goulist
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ConcurrentList is an unrolled linked list safe for concurrent use, where
+// each node is guarded by its own lock instead of a single lock of the list
+// (see SyncList). Operations walk the list with hand-over-hand locking: the
+// lock of the next node is taken before the lock of the current one is
+// released. Locks are always taken from the first node to the last one,
+// so operations do not deadlock and can not overtake each other. Locks are
+// kept by the list, not by nodes, so nodes of other lists do not carry them.
+//
+// Structural changes lock all of the nodes they touch: split locks the node
+// and its next node, redistribution after deletion locks the previous node,
+// the node itself and two following ones. The first node is never removed,
+// and Push locks only the last node, so appending to the tail and reading
+// from the head do not contend.
+//
+// Each operation is atomic, but there is no atomic view of the whole list:
+// ExportElems, IsContains and Do see changes made behind them by concurrent
+// operations, and Len is exact only when there are no concurrent changes.
+type ConcurrentList[T any] struct {
+	first  *ulistNode[T] // never removed from the list
+	last   atomic.Pointer[ulistNode[T]]
+	size   atomic.Int64 // number of nodes
+	length atomic.Int64 // number of elements
+	locks  sync.Map     // lock of each node, *ulistNode[T] to *sync.RWMutex
+}
+
+// ConcurrentUlist is a ConcurrentList of interface{} elements.
+type ConcurrentUlist = ConcurrentList[interface{}]
+
+// newConcurrentList creates new empty concurrent list with node capacity c,
+// configured by given options.
+func newConcurrentList[T any](c int, opts ...Option) *ConcurrentList[T] {
+	cl := &ConcurrentList[T]{
+		first: newUlist[T](c, opts...).first,
+	}
+
+	cl.locks.Store(cl.first, &sync.RWMutex{})
+	cl.last.Store(cl.first)
+	cl.size.Store(1)
+
+	return cl
+}
+
+// NewConcurrentUlist creates new empty ConcurrentUlist with node capacity
+// CacheLineSize. Options are applied as for NewUlist.
+func NewConcurrentUlist(opts ...Option) *ConcurrentUlist {
+	return newConcurrentList[interface{}](CacheLineSize, opts...)
+}
+
+// NewConcurrentUlistCustomCap creates new empty ConcurrentUlist with node
+// capacity c. Options are applied as for NewUlistCustomCap.
+func NewConcurrentUlistCustomCap(c int, opts ...Option) *ConcurrentUlist {
+	return newConcurrentList[interface{}](c, opts...)
+}
+
+// NewConcurrentList creates new empty ConcurrentList of elements of type T
+// with node capacity CacheLineSize. Options are applied as for NewList.
+func NewConcurrentList[T any](opts ...Option) *ConcurrentList[T] {
+	return newConcurrentList[T](CacheLineSize, opts...)
+}
+
+// NewConcurrentListCustomCap creates new empty ConcurrentList of elements
+// of type T with node capacity c. Options are applied as for NewListCustomCap.
+func NewConcurrentListCustomCap[T any](c int, opts ...Option) *ConcurrentList[T] {
+	return newConcurrentList[T](c, opts...)
+}
+
+// NodeCapacity returns max number of elements of each list's node.
+func (cl *ConcurrentList[T]) NodeCapacity() int {
+	return cl.first.capacity
+}
+
+// GetSize returns number of list's nodes.
+func (cl *ConcurrentList[T]) GetSize() int {
+	return int(cl.size.Load())
+}
+
+// Len returns number of list's elements.
+func (cl *ConcurrentList[T]) Len() int {
+	return int(cl.length.Load())
+}
+
+// isStale checks if locked node was removed from the list or was not
+// the last one anymore when its lock was taken.
+func (cl *ConcurrentList[T]) isStale(node *ulistNode[T]) bool {
+	return node.next != nil || cl.isRemoved(node)
+}
+
+// isRemoved checks if locked node was removed from the list. Nodes of
+// the list are never empty, except the first one, which is never removed.
+func (cl *ConcurrentList[T]) isRemoved(node *ulistNode[T]) bool {
+	return node.size == 0 && node != cl.first
+}
+
+// lockOf returns lock of the node. The node must be reachable from
+// the first node or locked.
+func (cl *ConcurrentList[T]) lockOf(node *ulistNode[T]) *sync.RWMutex {
+	mu, _ := cl.locks.Load(node)
+
+	return mu.(*sync.RWMutex)
+}
+
+// lock takes write lock of the node.
+func (cl *ConcurrentList[T]) lock(node *ulistNode[T]) {
+	cl.lockOf(node).Lock()
+}
+
+// unlock releases write lock of the node. Lock of the node removed from
+// the list is dropped, since the node can not be reached by links anymore.
+func (cl *ConcurrentList[T]) unlock(node *ulistNode[T]) {
+	mu := cl.lockOf(node)
+
+	if cl.isRemoved(node) {
+		cl.locks.Delete(node)
+	}
+
+	mu.Unlock()
+}
+
+// linkNew links newNode returned by split of the locked node after it.
+// It locks the next node to link newNode before it. Does nothing if newNode
+// is nil.
+func (cl *ConcurrentList[T]) linkNew(node, newNode *ulistNode[T]) {
+	if newNode == nil {
+		return
+	}
+
+	next := node.next
+
+	// the new node gets its lock before it can be reached
+	cl.locks.Store(newNode, &sync.RWMutex{})
+
+	if next != nil {
+		cl.lock(next)
+		next.prev = newNode
+		defer cl.unlock(next)
+	} else {
+		cl.last.Store(newNode)
+	}
+
+	newNode.next = next
+	newNode.prev = node
+	node.next = newNode
+
+	cl.size.Add(1)
+}
+
+// afterDeletion redistributes elements after deletion from the node (see
+// redistribAfterDeletion()) and removes the node if it becomes empty. Both
+// the node and its previous node prev (nil for the first one) must be locked,
+// the following nodes are locked by the function itself.
+func (cl *ConcurrentList[T]) afterDeletion(prev, node *ulistNode[T]) {
+	var (
+		next = node.next
+		nn   *ulistNode[T]
+	)
+
+	// following nodes are not changed if the node is filled enough
+	if next != nil && (node.size < node.minFill() || node.size == 0) {
+		cl.lock(next)
+		defer cl.unlock(next)
+
+		if nn = next.next; nn != nil {
+			cl.lock(nn)
+			defer cl.unlock(nn)
+		}
+	} else {
+		next = nil
+	}
+
+	k := node.redistribAfterDeletion()
+
+	// the first node is never removed, so it takes all of the next
+	// node's elements instead
+	if k == 0 && node.size == 0 && node == cl.first && next != nil {
+		node.moveFromNext(next.size)
+		node.next = nn
+
+		if nn != nil {
+			nn.prev = node
+		}
+
+		k = 1
+	}
+
+	if k > 0 {
+		cl.size.Add(-1)
+
+		if node.next == nil {
+			cl.last.Store(node)
+		}
+	}
+
+	if node.size == 0 && node != cl.first {
+		prev.next = node.next
+
+		if node.next != nil {
+			node.next.prev = prev
+		} else {
+			cl.last.Store(prev)
+		}
+
+		cl.size.Add(-1)
+	}
+}
+
+// lockElem finds node containing element with the given logical index and
+// index of the element in that node, walking the list from the first node
+// with write locks. If end is true, index equal to list's length is found
+// as the place after the last element of the last node. On success, both
+// the node and its previous node (nil for the first one) are returned locked.
+// If index is out of range, it returns error and no locks are held.
+func (cl *ConcurrentList[T]) lockElem(index int, end bool) (*ulistNode[T], *ulistNode[T], int, error) {
+	if index < 0 {
+		return nil, nil, 0, errors.New("Element index is out of range")
+	}
+
+	var (
+		prev *ulistNode[T]
+		node = cl.first
+	)
+
+	cl.lock(node)
+
+	for index >= node.size {
+		if node.next == nil {
+			if end && index == node.size {
+				return prev, node, index, nil
+			}
+
+			cl.unlockPair(prev, node)
+
+			return nil, nil, 0, errors.New("Element index is out of range")
+		}
+
+		index -= node.size
+
+		next := node.next
+		cl.lock(next)
+
+		if prev != nil {
+			cl.unlock(prev)
+		}
+
+		prev, node = node, next
+	}
+
+	return prev, node, index, nil
+}
+
+// unlockPair releases locks of the node and its previous node prev.
+func (cl *ConcurrentList[T]) unlockPair(prev, node *ulistNode[T]) {
+	cl.unlock(node)
+
+	if prev != nil {
+		cl.unlock(prev)
+	}
+}
+
+// Push appends element val to the end of the list. It locks only the last
+// node. Returns error on failure.
+func (cl *ConcurrentList[T]) Push(val T) error {
+	for {
+		last := cl.last.Load()
+		mu, ok := cl.locks.Load(last)
+
+		// the last node has been removed, before its lock was found
+		if !ok {
+			continue
+		}
+
+		mu.(*sync.RWMutex).Lock()
+
+		// the last node has been split or removed, while waiting for its lock
+		if cl.isStale(last) {
+			mu.(*sync.RWMutex).Unlock()
+			continue
+		}
+
+		cl.linkNew(last, last.add(val))
+		cl.length.Add(1)
+
+		mu.(*sync.RWMutex).Unlock()
+
+		return nil
+	}
+}
+
+// PushFront inserts element val before the first element of the list.
+// It locks only the first node, unless it is split.
+func (cl *ConcurrentList[T]) PushFront(val T) error {
+	cl.lock(cl.first)
+	defer cl.unlock(cl.first)
+
+	cl.linkNew(cl.first, cl.first.insert(0, val))
+	cl.length.Add(1)
+
+	return nil
+}
+
+// PeekFront returns the first element of the list without removing it.
+// It returns false if the list is empty.
+func (cl *ConcurrentList[T]) PeekFront() (T, bool) {
+	var zero T
+
+	mu := cl.lockOf(cl.first)
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if cl.first.size == 0 {
+		return zero, false
+	}
+
+	return cl.first.elems[0], true
+}
+
+// At returns element with the given logical index and error if index
+// is out of range.
+func (cl *ConcurrentList[T]) At(index int) (T, error) {
+	var (
+		val T
+		err = errors.New("Element index is out of range")
+	)
+
+	if index < 0 {
+		return val, err
+	}
+
+	cl.rwalk(func(node *ulistNode[T]) bool {
+		if index < node.size {
+			val = node.elems[index]
+			err = nil
+
+			return false
+		}
+
+		index -= node.size
+
+		return true
+	})
+
+	return val, err
+}
+
+// SetAt replaces element with the given logical index with given element val.
+// Returns new value of the element and error if index is out of range.
+func (cl *ConcurrentList[T]) SetAt(index int, val T) (T, error) {
+	var zero T
+
+	prev, node, i, err := cl.lockElem(index, false)
+
+	if err != nil {
+		return zero, err
+	}
+
+	defer cl.unlockPair(prev, node)
+
+	node.elems[i] = val
+
+	return node.elems[i], err
+}
+
+// InsertAt inserts a new element val before element with the given logical
+// index, so val gets that index. If index is equal to list's length, val
+// is appended to the end of list. Returns error if index is out of range.
+func (cl *ConcurrentList[T]) InsertAt(index int, val T) error {
+	prev, node, i, err := cl.lockElem(index, true)
+
+	if err != nil {
+		return err
+	}
+
+	defer cl.unlockPair(prev, node)
+
+	cl.linkNew(node, node.insert(i, val))
+	cl.length.Add(1)
+
+	return err
+}
+
+// RemoveAt removes element with the given logical index from the list
+// and redistributes elements between nodes if needed.
+// Returns removed element and error if index is out of range.
+func (cl *ConcurrentList[T]) RemoveAt(index int) (T, error) {
+	var zero T
+
+	prev, node, i, err := cl.lockElem(index, false)
+
+	if err != nil {
+		return zero, err
+	}
+
+	defer cl.unlockPair(prev, node)
+
+	val := node.elems[i]
+
+	if _, err = node.del(i); err != nil {
+		return zero, err
+	}
+
+	cl.length.Add(-1)
+	cl.afterDeletion(prev, node)
+
+	return val, err
+}
+
+// RemoveAllOccurrences removes all occurrences of element val from the list.
+// It walks the list once, holding locks of the current node and of its
+// previous node.
+func (cl *ConcurrentList[T]) RemoveAllOccurrences(val T) {
+	var (
+		prev *ulistNode[T]
+		node = cl.first
+	)
+
+	cl.lock(node)
+
+	for node != nil {
+		// elements moved from the next node are checked too
+		for {
+			n := node.compact(val)
+
+			if n == 0 {
+				break
+			}
+
+			cl.length.Add(-int64(n))
+			cl.afterDeletion(prev, node)
+		}
+
+		// node is removed, its previous node is kept locked
+		if node.size == 0 && node != cl.first {
+			next := prev.next
+
+			if next != nil {
+				cl.lock(next)
+			}
+
+			cl.unlock(node)
+			node = next
+
+			continue
+		}
+
+		next := node.next
+
+		if next != nil {
+			cl.lock(next)
+		}
+
+		if prev != nil {
+			cl.unlock(prev)
+		}
+
+		prev, node = node, next
+	}
+
+	if prev != nil {
+		cl.unlock(prev)
+	}
+}
+
+// rwalk calls fn on each list's node under read lock, until fn returns false.
+// The lock of the next node is taken before the lock of the current one
+// is released.
+func (cl *ConcurrentList[T]) rwalk(fn func(*ulistNode[T]) bool) {
+	var (
+		node = cl.first
+		mu   = cl.lockOf(node)
+	)
+
+	mu.RLock()
+
+	for fn(node) && node.next != nil {
+		next := node.next
+		nextMu := cl.lockOf(next)
+		nextMu.RLock()
+		mu.RUnlock()
+		node, mu = next, nextMu
+	}
+
+	mu.RUnlock()
+}
+
+// Do calls function fn on each list's element. Each node is locked for
+// writing while fn is called on its elements, so fn may change elements
+// through given pointers. fn must not retain the pointers and must not
+// call methods of the list.
+func (cl *ConcurrentList[T]) Do(fn func(*T)) {
+	node := cl.first
+	cl.lock(node)
+
+	for {
+		node.do(fn)
+
+		next := node.next
+
+		if next == nil {
+			break
+		}
+
+		cl.lock(next)
+		cl.unlock(node)
+		node = next
+	}
+
+	cl.unlock(node)
+}
+
+// ExportElems returns slice filled with all list's elements.
+func (cl *ConcurrentList[T]) ExportElems() []T {
+	target := []T{}
+
+	cl.rwalk(func(node *ulistNode[T]) bool {
+		target = append(target, node.elems[:node.size]...)
+		return true
+	})
+
+	return target
+}
+
+// IsContains returns true if list contains at least one element val.
+func (cl *ConcurrentList[T]) IsContains(val T) bool {
+	check := false
+
+	cl.rwalk(func(node *ulistNode[T]) bool {
+		for i := 0; i < node.size; i++ {
+			if node.equals(val, node.elems[i]) {
+				check = true
+				break
+			}
+		}
+
+		return !check
+	})
+
+	return check
+}
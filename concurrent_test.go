@@ -0,0 +1,282 @@
+package goulist
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// checkConcurrentList checks links, sizes and counters of the list,
+// which must not be changed concurrently.
+func checkConcurrentList[T any](t *testing.T, cl *ConcurrentList[T]) {
+	t.Helper()
+
+	var (
+		size   = 0
+		length = 0
+		prev   *ulistNode[T]
+	)
+
+	for node := cl.first; node != nil; node = node.next {
+		if node.prev != prev {
+			t.Fatalf("Node %d has wrong link to previous node", size)
+		}
+
+		if _, ok := cl.locks.Load(node); !ok {
+			t.Fatalf("Node %d has no lock", size)
+		}
+
+		if node.size == 0 && (node != cl.first || node.next != nil) {
+			t.Fatalf("Node %d is empty", size)
+		}
+
+		prev = node
+		size++
+		length += node.size
+	}
+
+	if cl.last.Load() != prev || cl.GetSize() != size || cl.Len() != length {
+		t.Fatalf("List counters are size %d, length %d, want %d, %d",
+			cl.GetSize(), cl.Len(), size, length)
+	}
+
+	locks := 0
+
+	cl.locks.Range(func(_, _ any) bool {
+		locks++
+		return true
+	})
+
+	// locks of removed nodes are dropped
+	if locks != size {
+		t.Fatalf("List keeps %d locks for %d nodes", locks, size)
+	}
+}
+
+func TestConcurrentList(t *testing.T) {
+	var (
+		cl   = NewConcurrentListCustomCap[int](nodeSize)
+		want = []int{}
+	)
+
+	if _, ok := cl.PeekFront(); ok {
+		t.Errorf("ConcurrentList.PeekFront() = true for empty list")
+	}
+
+	for i := 0; i < 30; i++ {
+		switch i % 3 {
+		case 0:
+			cl.Push(i)
+			want = append(want, i)
+		case 1:
+			cl.PushFront(i)
+			want = append([]int{i}, want...)
+		default:
+			index := len(want) / 2
+			cl.InsertAt(index, i)
+			want = append(want[:index], append([]int{i}, want[index:]...)...)
+		}
+	}
+
+	checkConcurrentList(t, cl)
+
+	if got := cl.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ConcurrentList elements = %v, want %v", got, want)
+	}
+
+	for i, v := range want {
+		if got, err := cl.At(i); err != nil || got != v {
+			t.Errorf("ConcurrentList.At(%d) = %v, %v, want %v", i, got, err, v)
+		}
+	}
+
+	if _, err := cl.At(len(want)); err == nil {
+		t.Errorf("ConcurrentList.At() error = nil for index out of range")
+	}
+
+	if err := cl.InsertAt(len(want)+1, 0); err == nil {
+		t.Errorf("ConcurrentList.InsertAt() error = nil for index out of range")
+	}
+
+	if v, err := cl.SetAt(3, 7); err != nil || v != 7 || !cl.IsContains(7) {
+		t.Errorf("ConcurrentList.SetAt() = %v, %v, want 7", v, err)
+	}
+
+	want[3] = 7
+
+	cl.Do(func(p *int) {
+		*p %= 3
+	})
+
+	for i := range want {
+		want[i] %= 3
+	}
+
+	cl.RemoveAllOccurrences(1)
+
+	k := 0
+
+	for _, v := range want {
+		if v != 1 {
+			want[k] = v
+			k++
+		}
+	}
+
+	want = want[:k]
+
+	checkConcurrentList(t, cl)
+
+	if got := cl.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ConcurrentList.RemoveAllOccurrences() = %v, want %v", got, want)
+	}
+
+	for len(want) > 0 {
+		index := (len(want) * 5) % len(want) / 2
+
+		if got, err := cl.RemoveAt(index); err != nil || got != want[index] {
+			t.Fatalf("ConcurrentList.RemoveAt() = %v, %v, want %v", got, err, want[index])
+		}
+
+		want = append(want[:index], want[index+1:]...)
+
+		checkConcurrentList(t, cl)
+	}
+
+	if _, err := cl.RemoveAt(0); err == nil {
+		t.Errorf("ConcurrentList.RemoveAt() error = nil for empty list")
+	}
+}
+
+func TestConcurrentList_MinFillZero(t *testing.T) {
+	cl := NewConcurrentListCustomCap[int](nodeSize, WithMinFill(0))
+
+	for i := 0; i < 12; i++ {
+		cl.Push(i)
+	}
+
+	// every node is emptied one by one
+	for i := 0; i < 12; i++ {
+		if _, err := cl.RemoveAt(0); err != nil {
+			t.Fatalf("ConcurrentList.RemoveAt() error = %v", err)
+		}
+
+		checkConcurrentList(t, cl)
+	}
+
+	if cl.Len() != 0 || cl.GetSize() != 1 {
+		t.Errorf("ConcurrentList is not empty after removing of all elements")
+	}
+}
+
+// TestConcurrentList_Stress runs appenders at the tail, readers at the head
+// and writers in the middle of the list at the same time. It is meant to be
+// run with the race detector:
+//
+// 	go test -race -run ConcurrentList
+func TestConcurrentList_Stress(t *testing.T) {
+	const (
+		workers = 4
+		rounds  = 1000
+	)
+
+	var (
+		cl = NewConcurrentUlistCustomCap(nodeSize)
+		wg sync.WaitGroup
+	)
+
+	// negative elements in front of appended ones are changed by writers
+	for i := 0; i < 200; i++ {
+		cl.Push(-1)
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(4)
+
+		// appenders at the tail
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < rounds; i++ {
+				cl.Push(w*rounds + i)
+			}
+		}(w)
+
+		// readers at the head
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < rounds; i++ {
+				cl.PeekFront()
+				cl.At(i % 50)
+			}
+		}()
+
+		// writers in the middle, which keep the number of elements
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < rounds; i++ {
+				index := (i * 7) % 100
+
+				if i%2 == 0 {
+					cl.InsertAt(index, -2)
+				} else {
+					cl.RemoveAt(index)
+				}
+
+				cl.SetAt(index, -3)
+				cl.IsContains(-4)
+			}
+		}(w)
+
+		// walkers over the whole list
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < rounds/50; i++ {
+				for j := 0; j < 10; j++ {
+					cl.InsertAt(j*10, -4)
+				}
+
+				cl.ExportElems()
+				cl.Do(func(p *interface{}) {})
+				cl.RemoveAllOccurrences(-4)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	checkConcurrentList(t, cl)
+
+	// all of the appended elements are kept in order of each appender
+	last := make([]int, workers)
+
+	for i := range last {
+		last[i] = -1
+	}
+
+	count := 0
+
+	for _, v := range cl.ExportElems() {
+		n := v.(int)
+
+		if n < 0 {
+			continue
+		}
+
+		w, i := n/rounds, n%rounds
+
+		if i <= last[w] {
+			t.Fatalf("Element %d of appender %d follows element %d", i, w, last[w])
+		}
+
+		last[w] = i
+		count++
+	}
+
+	if count != workers*rounds {
+		t.Errorf("ConcurrentList kept %d appended elements, want %d", count, workers*rounds)
+	}
+}
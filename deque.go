@@ -0,0 +1,97 @@
+package goulist
+
+// PushFront inserts new element val to the start of list. If the first node
+// is full, it is split in two halves first. Returns the error on failure.
+func (ul *List[T]) PushFront(val T) error {
+	var err error
+
+	ul.unshare()
+
+	newNode := ul.first.insert(0, val)
+
+	if newNode != nil {
+		ul.linkAfter(ul.first, newNode)
+	}
+
+	ul.length++
+
+	return err
+}
+
+// PopFront removes the first list's element and returns it. Elements are
+// redistributed between first nodes if the first node becomes less than
+// half-full. It returns false if list is empty.
+func (ul *List[T]) PopFront() (T, bool) {
+	var zero T
+
+	if ul.length == 0 {
+		return zero, false
+	}
+
+	ul.unshare()
+
+	node := ul.first
+	val := node.elems[0]
+
+	n, _ := node.delAt(0)
+
+	ul.length--
+	ul.afterDeletion(node, n)
+
+	return val, true
+}
+
+// PopBack removes the last list's element and returns it. If the last node
+// becomes less than half-full (see WithMinFill) and its elements fit into
+// the previous node, they are moved there and the last node is deleted.
+// It returns false if list is empty.
+func (ul *List[T]) PopBack() (T, bool) {
+	var zero T
+
+	if ul.length == 0 {
+		return zero, false
+	}
+
+	ul.unshare()
+
+	node := ul.last
+	val := node.elems[node.size-1]
+
+	n, _ := node.delAt(node.size - 1)
+
+	ul.length--
+	ul.afterDeletion(node, n)
+
+	if node = ul.last; node.prev != nil &&
+		node.size < node.minFill() &&
+		node.prev.size+node.size <= node.capacity {
+		node.prev.moveFromNext(node.size)
+		ul.unlink(node)
+	}
+
+	return val, true
+}
+
+// PeekFront returns the first list's element without removing it.
+// It returns false if list is empty.
+func (ul *List[T]) PeekFront() (T, bool) {
+	var zero T
+
+	if ul.length == 0 {
+		return zero, false
+	}
+
+	return ul.first.elems[0], true
+}
+
+// PeekBack returns the last list's element without removing it.
+// It returns false if list is empty.
+func (ul *List[T]) PeekBack() (T, bool) {
+	var zero T
+
+	if ul.length == 0 {
+		return zero, false
+	}
+
+	return ul.last.elems[ul.last.size-1], true
+}
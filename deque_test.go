@@ -0,0 +1,146 @@
+package goulist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestList_PushFront(t *testing.T) {
+	ul := NewListCustomCap[int](nodeSize)
+	want := []int{}
+
+	for i := 0; i < 10; i++ {
+		if err := ul.PushFront(i); err != nil {
+			t.Fatalf("List.PushFront() error = %v", err)
+		}
+
+		want = append([]int{i}, want...)
+	}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) || ul.Len() != 10 {
+		t.Errorf("List.PushFront() = %v, want %v", got, want)
+	}
+
+	for node := ul.first; node != nil; node = node.next {
+		if node.size < nodeSize/2 {
+			t.Errorf("List.PushFront() left node with %d elements", node.size)
+		}
+	}
+}
+
+func TestList_PopFront(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+
+	for i := 0; i < 10; i++ {
+		if got, ok := ul.PopFront(); !ok || got != i {
+			t.Fatalf("List.PopFront() = %v, %v, want %v", got, ok, i)
+		}
+
+		if err := ul.Validate(); err != nil || ul.Len() != 9-i {
+			t.Fatalf("List.PopFront() broke list's structure: %v", err)
+		}
+	}
+
+	if got, ok := ul.PopFront(); ok || got != 0 {
+		t.Errorf("List.PopFront() = %v, %v on empty list", got, ok)
+	}
+
+	if ul.GetSize() != 1 {
+		t.Errorf("List size = %d after popping of all elements", ul.GetSize())
+	}
+}
+
+func TestList_PopBack(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+
+	for i := 9; i >= 0; i-- {
+		if got, ok := ul.PopBack(); !ok || got != i {
+			t.Fatalf("List.PopBack() = %v, %v, want %v", got, ok, i)
+		}
+
+		if ul.Len() != i || ul.last.next != nil {
+			t.Fatalf("List.PopBack() broke list's structure")
+		}
+
+		for node := ul.first; node != ul.last; node = node.next {
+			if node.size < nodeSize/2 {
+				t.Fatalf("List.PopBack() left node with %d elements", node.size)
+			}
+		}
+	}
+
+	if got, ok := ul.PopBack(); ok || got != 0 {
+		t.Errorf("List.PopBack() = %v, %v on empty list", got, ok)
+	}
+
+	if ul.GetSize() != 1 {
+		t.Errorf("List size = %d after popping of all elements", ul.GetSize())
+	}
+}
+
+func TestList_Peek(t *testing.T) {
+	ul := NewListCustomCap[int](nodeSize)
+
+	if _, ok := ul.PeekFront(); ok {
+		t.Errorf("List.PeekFront() = true on empty list")
+	}
+
+	if _, ok := ul.PeekBack(); ok {
+		t.Errorf("List.PeekBack() = true on empty list")
+	}
+
+	ul.PushAll([]int{1, 2, 3, 4, 5})
+
+	if got, ok := ul.PeekFront(); !ok || got != 1 {
+		t.Errorf("List.PeekFront() = %v, %v, want %v", got, ok, 1)
+	}
+
+	if got, ok := ul.PeekBack(); !ok || got != 5 {
+		t.Errorf("List.PeekBack() = %v, %v, want %v", got, ok, 5)
+	}
+
+	if ul.Len() != 5 {
+		t.Errorf("List.Peek...() removed elements")
+	}
+}
+
+func TestList_Deque(t *testing.T) {
+	ul := NewListCustomCap[int](nodeSize)
+	model := []int{}
+
+	// work queue: push to both ends, pop from front
+	for i := 0; i < 100; i++ {
+		switch i % 5 {
+		case 0, 1:
+			ul.Push(i)
+			model = append(model, i)
+		case 2:
+			ul.PushFront(i)
+			model = append([]int{i}, model...)
+		case 3:
+			got, _ := ul.PopFront()
+
+			if got != model[0] {
+				t.Fatalf("List.PopFront() = %v, want %v", got, model[0])
+			}
+
+			model = model[1:]
+		case 4:
+			got, _ := ul.PopBack()
+
+			if got != model[len(model)-1] {
+				t.Fatalf("List.PopBack() = %v, want %v", got, model[len(model)-1])
+			}
+
+			model = model[:len(model)-1]
+		}
+
+		if err := ul.Validate(); err != nil {
+			t.Fatalf("List.Validate() error = %v", err)
+		}
+	}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, model) {
+		t.Errorf("List elements = %v, want %v", got, model)
+	}
+}
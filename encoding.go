@@ -0,0 +1,189 @@
+package goulist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"unsafe"
+)
+
+// binaryPolicy is a serialized form of the split and merge policy of nodes.
+// It is written to JSON documents with node layout too (see MarshalJSON).
+type binaryPolicy struct {
+	MinFill     int  `json:"minFill"`
+	Split       int  `json:"split"`
+	AppendSplit bool `json:"appendSplit,omitempty"`
+	JSONLayout  bool `json:"-"`
+}
+
+// options returns options of nodes of capacity c with the policy.
+// Returns error if the policy does not fit the capacity.
+func (p *binaryPolicy) options(c int) (*options, error) {
+	if p.MinFill < 0 || p.MinFill > c/2 || p.Split < 1 || p.Split > c {
+		return nil, errors.New("Malformed list policy data")
+	}
+
+	return &options{
+		capacity:    c,
+		minFill:     p.MinFill,
+		split:       p.Split,
+		appendSplit: p.AppendSplit,
+		jsonLayout:  p.JSONLayout,
+	}, nil
+}
+
+// binaryList is a serialized form of the list. It keeps node capacity and
+// elements of each node, so the node layout is restored on decoding.
+type binaryList[T any] struct {
+	Capacity int
+	Nodes    [][]T
+	Policy   *binaryPolicy // nil for default policy
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. It writes node capacity,
+// split and merge policy and elements of each node, so decoded list has the
+// same node layout. Zero List is encoded as an empty list. Elements are
+// encoded with encoding/gob, so concrete types stored in Ulist must be
+// registered with gob.Register.
+func (ul *List[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+
+	// zero List is encoded as an empty node of default capacity
+	if ul.first == nil {
+		err := gob.NewEncoder(&buf).Encode(binaryList[T]{
+			Capacity: CacheLineSize,
+			Nodes:    [][]T{{}},
+		})
+
+		return buf.Bytes(), err
+	}
+
+	bl := binaryList[T]{
+		Capacity: ul.first.capacity,
+		Nodes:    make([][]T, 0, ul.size),
+	}
+
+	for node := ul.first; node != nil; node = node.next {
+		bl.Nodes = append(bl.Nodes, node.elems[:node.size])
+	}
+
+	if o := ul.first.opts; o != nil {
+		bl.Policy = &binaryPolicy{
+			MinFill:     o.minFill,
+			Split:       o.split,
+			AppendSplit: o.appendSplit,
+			JSONLayout:  o.jsonLayout,
+		}
+	}
+
+	err := gob.NewEncoder(&buf).Encode(bl)
+
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces list's
+// content with the list encoded by MarshalBinary. Node layout is checked
+// before it replaces list's content (see restore). Returns error if data
+// is malformed, list is not changed in this case.
+func (ul *List[T]) UnmarshalBinary(data []byte) error {
+	var bl binaryList[T]
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&bl)
+
+	if err != nil {
+		return err
+	}
+
+	if bl.Capacity < 1 || len(bl.Nodes) == 0 {
+		return errors.New("Malformed list data")
+	}
+
+	var opts *options
+
+	if bl.Policy != nil {
+		if opts, err = bl.Policy.options(bl.Capacity); err != nil {
+			return err
+		}
+	}
+
+	return ul.restore(bl.Capacity, bl.Nodes, opts)
+}
+
+// restore replaces list's content with nodes of capacity c filled with
+// given elements and sharing given policy. Returns error if nodes
+// are malformed: capacity is out of range [1, MaxNodeCapacity], node has more
+// elements than capacity, or node other than the last one is empty or
+// filled less than min fill. List is not changed in this case. Equality
+// function of the list (see WithEqual) is kept with the given policy.
+func (ul *List[T]) restore(c int, nodes [][]T, opts *options) error {
+	var (
+		first  *ulistNode[T]
+		last   *ulistNode[T]
+		length = 0
+		m      = c / 2
+	)
+
+	if c < 1 || c > MaxNodeCapacity || len(nodes) == 0 {
+		return errors.New("Malformed list data")
+	}
+
+	if opts != nil {
+		m = opts.minFill
+	}
+
+	for i, elems := range nodes {
+		if len(elems) > c {
+			return errors.New("Malformed list node data")
+		}
+
+		if i < len(nodes)-1 && (len(elems) == 0 || len(elems) < m) {
+			return errors.New("Malformed list node data")
+		}
+	}
+
+	// equality function is not encoded, so the one of the list is kept
+	if ul.first != nil && ul.first.opts != nil && ul.first.opts.equal != nil {
+		if opts == nil {
+			opts = newOptions(c, unsafe.Sizeof(*new(T)), []Option{WithCapacity(c)})
+		} else {
+			o := *opts
+			opts = &o
+		}
+
+		opts.equal = ul.first.opts.equal
+	}
+
+	for _, elems := range nodes {
+		node := newUlistNode[T](c)
+		node.opts = opts
+		node.size = copy(node.elems, elems)
+		length += node.size
+
+		if last == nil {
+			first = node
+		} else {
+			last.next = node
+			node.prev = last
+		}
+
+		last = node
+	}
+
+	ul.first = first
+	ul.last = last
+	ul.size = len(nodes)
+	ul.length = length
+	ul.shared = false
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder, see MarshalBinary.
+func (ul *List[T]) GobEncode() ([]byte, error) {
+	return ul.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder, see UnmarshalBinary.
+func (ul *List[T]) GobDecode(data []byte) error {
+	return ul.UnmarshalBinary(data)
+}
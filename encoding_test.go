@@ -0,0 +1,208 @@
+package goulist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+)
+
+// checkSameLayout reports error if lists have different node layout
+// or elements, or list got is broken.
+func checkSameLayout[T any](t *testing.T, got, want *List[T]) {
+	t.Helper()
+
+	if err := got.Validate(); err != nil {
+		t.Errorf("List structure is broken: %v", err)
+	}
+
+	if got.GetSize() != want.GetSize() || got.Len() != want.Len() ||
+		got.NodeCapacity() != want.NodeCapacity() {
+		t.Errorf(
+			"List has %d nodes, %d elements and capacity %d, want %d, %d and %d",
+			got.GetSize(), got.Len(), got.NodeCapacity(),
+			want.GetSize(), want.Len(), want.NodeCapacity(),
+		)
+	}
+
+	if !reflect.DeepEqual(got.GetFirst(), want.GetFirst()) {
+		t.Errorf("GetFirst() = %v, want %v", got.GetFirst(), want.GetFirst())
+	}
+
+	if !reflect.DeepEqual(got.GetLast(), want.GetLast()) {
+		t.Errorf("GetLast() = %v, want %v", got.GetLast(), want.GetLast())
+	}
+
+	if !reflect.DeepEqual(nodeSizes(got), nodeSizes(want)) {
+		t.Errorf("Node sizes = %v, want %v", nodeSizes(got), nodeSizes(want))
+	}
+
+	if !reflect.DeepEqual(got.ExportElems(), want.ExportElems()) {
+		t.Errorf("ExportElems() = %v, want %v", got.ExportElems(), want.ExportElems())
+	}
+}
+
+func TestList_MarshalBinary(t *testing.T) {
+	var (
+		empty   = NewListCustomCap[string](nodeSize)
+		full    = NewListCustomCap[string](nodeSize)
+		options = NewListCustomCap[string](8, WithMinFill(2), WithAppendOptimizedSplit())
+	)
+
+	for i := 0; i < 30; i++ {
+		empty.Push("")
+		full.InsertAt(i/2, string(rune('a'+i)))
+		options.Push(string(rune('a' + i)))
+	}
+
+	empty.Clear()
+
+	tests := []struct {
+		name string
+		ul   *List[string]
+	}{
+		{"marshalEmptyTest", empty},
+		{"marshalTest", full},
+		{"marshalWithOptionsTest", options},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.ul.MarshalBinary()
+
+			if err != nil {
+				t.Fatalf("List.MarshalBinary() error = %v", err)
+			}
+
+			got := &List[string]{}
+
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("List.UnmarshalBinary() error = %v", err)
+			}
+
+			checkSameLayout(t, got, tt.ul)
+
+			if o := tt.ul.first.opts; o != nil && (got.first.opts == nil ||
+				got.first.opts.minFill != o.minFill ||
+				got.first.opts.split != o.split ||
+				got.first.opts.appendSplit != o.appendSplit) {
+				t.Errorf("List policy = %v, want %v", got.first.opts, o)
+			}
+
+			// decoded list keeps working
+			got.Push("z")
+
+			if got.Len() != tt.ul.Len()+1 {
+				t.Errorf("Decoded list length = %d, want %d", got.Len(), tt.ul.Len()+1)
+			}
+
+			if err := got.Validate(); err != nil {
+				t.Errorf("List.Push() broke decoded list's structure: %v", err)
+			}
+		})
+	}
+}
+
+func TestList_UnmarshalBinaryErrors(t *testing.T) {
+	encode := func(bl binaryList[int]) []byte {
+		var buf bytes.Buffer
+
+		gob.NewEncoder(&buf).Encode(bl)
+
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"garbageTest", []byte("garbage")},
+		{"zeroCapacityTest", encode(binaryList[int]{0, [][]int{{1}}, nil})},
+		{"noNodesTest", encode(binaryList[int]{4, [][]int{}, nil})},
+		{"nodeOverflowTest", encode(binaryList[int]{2, [][]int{{1, 2, 3}}, nil})},
+		{"emptyNodeTest", encode(binaryList[int]{4, [][]int{{}, {7}}, nil})},
+		{"underfilledNodeTest", encode(binaryList[int]{4, [][]int{{1}, {2, 3}}, nil})},
+		{
+			"underfilledPolicyNodeTest",
+			encode(binaryList[int]{4, [][]int{{1, 2}, {3}, {4, 5}}, &binaryPolicy{2, 2, false, false}}),
+		},
+		{"hugeCapacityTest", encode(binaryList[int]{1 << 40, [][]int{{1}}, nil})},
+		{
+			"wrongPolicyTest",
+			encode(binaryList[int]{4, [][]int{{1}}, &binaryPolicy{3, 2, false, false}}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ul := newRangeList(nodeSize, 0, 5)
+
+			if err := ul.UnmarshalBinary(tt.data); err == nil {
+				t.Errorf("List.UnmarshalBinary() error = nil")
+			}
+
+			if err := ul.Validate(); err != nil || ul.Len() != 5 {
+				t.Errorf("List.UnmarshalBinary() changed list on error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUlist_Gob(t *testing.T) {
+	type doc struct {
+		Name string
+		List *Ulist
+	}
+
+	ul := NewUlistCustomCap(nodeSize)
+
+	for i := 0; i < 10; i++ {
+		if i%3 == 0 {
+			ul.Push(nil)
+		} else {
+			ul.Push(i)
+		}
+	}
+
+	ul.Push("str")
+
+	var buf bytes.Buffer
+
+	if err := gob.NewEncoder(&buf).Encode(doc{"test", ul}); err != nil {
+		t.Fatalf("gob encoding error = %v", err)
+	}
+
+	var got doc
+
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("gob decoding error = %v", err)
+	}
+
+	if got.Name != "test" {
+		t.Errorf("Decoded name = %v, want %v", got.Name, "test")
+	}
+
+	checkSameLayout(t, got.List, ul)
+}
+
+func TestList_GobZeroField(t *testing.T) {
+	type doc struct {
+		L List[int]
+	}
+
+	var buf bytes.Buffer
+
+	if err := gob.NewEncoder(&buf).Encode(&doc{}); err != nil {
+		t.Fatalf("gob encoding error = %v", err)
+	}
+
+	var got doc
+
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("gob decoding error = %v", err)
+	}
+
+	if err := got.L.Validate(); err != nil || got.L.Len() != 0 {
+		t.Errorf("Decoded zero list has %d elements: %v", got.L.Len(), err)
+	}
+}
@@ -0,0 +1,156 @@
+package goulist
+
+// derive creates new empty list of elements of type U with the same node
+// capacity and policy as the list ul. Equality function of the list (see
+// WithEqual) is kept only if U is the same type as T.
+func derive[T, U any](ul *List[T]) *List[U] {
+	o := ul.first.opts
+
+	if o != nil && o.equal != nil {
+		if _, ok := o.equal.(func(a, b U) bool); !ok {
+			c := *o
+			c.equal = nil
+			o = &c
+		}
+	}
+
+	node := newUlistNode[U](ul.first.capacity)
+	node.opts = o
+
+	return &List[U]{
+		first: node,
+		last:  node,
+		size:  1,
+	}
+}
+
+// appendPacked appends element val to the end of the list, filling the last
+// node up to its capacity before creating a new one, so list built by
+// appendPacked consists of full nodes.
+func (ul *List[T]) appendPacked(val T) {
+	if ul.last.isFull() {
+		ul.linkAfter(ul.last, ul.last.newNode())
+	}
+
+	ul.last.elems[ul.last.size] = val
+	ul.last.size++
+	ul.length++
+}
+
+// Map returns new list with results of function fn called on each element
+// of the list ul, in the same order. The new list has the same capacity,
+// policy and layout of nodes as ul: each node of it holds results for
+// elements of the corresponding node of ul.
+func Map[T, U any](ul *List[T], fn func(T) U) *List[U] {
+	res := derive[T, U](ul)
+
+	for node, last := ul.first, res.first; node != nil; node = node.next {
+		if node != ul.first {
+			newNode := last.newNode()
+			res.linkAfter(last, newNode)
+			last = newNode
+		}
+
+		for i := 0; i < node.size; i++ {
+			last.elems[i] = fn(node.elems[i])
+		}
+
+		last.size = node.size
+		res.length += node.size
+	}
+
+	return res
+}
+
+// Filter returns new list with elements of the list ul for which pred returns
+// true, in the same order. The new list has the same capacity and policy
+// as ul, its nodes are fully packed.
+func Filter[T any](ul *List[T], pred func(T) bool) *List[T] {
+	res, _ := Partition(ul, pred)
+
+	return res
+}
+
+// Partition returns two new lists: with elements of the list ul for which
+// pred returns true and with the rest of elements, in the same order.
+// New lists have the same capacity and policy as ul, their nodes are
+// fully packed.
+func Partition[T any](ul *List[T], pred func(T) bool) (*List[T], *List[T]) {
+	var (
+		yes = derive[T, T](ul)
+		no  = derive[T, T](ul)
+	)
+
+	for v := range ul.Values() {
+		if pred(v) {
+			yes.appendPacked(v)
+		} else {
+			no.appendPacked(v)
+		}
+	}
+
+	return yes, no
+}
+
+// GroupBy returns new lists of elements of the list ul grouped by key
+// returned by function key, in the same order. New lists have the same
+// capacity and policy as ul, their nodes are fully packed.
+func GroupBy[T any, K comparable](ul *List[T], key func(T) K) map[K]*List[T] {
+	groups := make(map[K]*List[T])
+
+	for v := range ul.Values() {
+		k := key(v)
+		group, ok := groups[k]
+
+		if !ok {
+			group = derive[T, T](ul)
+			groups[k] = group
+		}
+
+		group.appendPacked(v)
+	}
+
+	return groups
+}
+
+// Reduce calls function fn on accumulator and each element of the list ul,
+// from the first to the last one, starting with accumulator init. Result
+// of each call becomes the accumulator for the next one. Returns the last
+// accumulator, or init if the list is empty.
+func Reduce[T, A any](ul *List[T], init A, fn func(A, T) A) A {
+	acc := init
+
+	for v := range ul.Values() {
+		acc = fn(acc, v)
+	}
+
+	return acc
+}
+
+// Any returns true if pred returns true for at least one element of the
+// list ul. It stops at the first such element.
+func Any[T any](ul *List[T], pred func(T) bool) bool {
+	return ul.ContainsFunc(pred)
+}
+
+// All returns true if pred returns true for all of the elements of the list
+// ul, or if the list is empty. It stops at the first element for which pred
+// returns false.
+func All[T any](ul *List[T], pred func(T) bool) bool {
+	return !ul.ContainsFunc(func(v T) bool {
+		return !pred(v)
+	})
+}
+
+// Count returns number of elements of the list ul for which pred returns true.
+func Count[T any](ul *List[T], pred func(T) bool) int {
+	n := 0
+
+	for v := range ul.Values() {
+		if pred(v) {
+			n++
+		}
+	}
+
+	return n
+}
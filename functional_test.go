@@ -0,0 +1,156 @@
+package goulist
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+	ul.RemoveAt(3)
+
+	res := Map(ul, strconv.Itoa)
+
+	if got, want := res.ExportElems(), []string{"0", "1", "2", "4", "5", "6", "7", "8", "9"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Map() = %v, want %v", got, want)
+	}
+
+	if got, want := nodeSizes(res), nodeSizes(ul); !reflect.DeepEqual(got, want) {
+		t.Errorf("Map() node sizes = %v, want %v", got, want)
+	}
+
+	if err := res.Validate(); err != nil || res.NodeCapacity() != ul.NodeCapacity() {
+		t.Errorf("Map() created broken list: %v", err)
+	}
+
+	if empty := Map(NewListCustomCap[int](nodeSize), strconv.Itoa); empty.Len() != 0 || empty.GetSize() != 1 {
+		t.Errorf("Map() of empty list has %d elements", empty.Len())
+	}
+}
+
+func TestPartition(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+	even := func(v int) bool { return v%2 == 0 }
+
+	yes, no := Partition(ul, even)
+
+	tests := []struct {
+		name      string
+		list      *List[int]
+		want      []int
+		wantSizes []int
+	}{
+		{"partitionYesTest", yes, []int{0, 2, 4, 6, 8}, []int{4, 1}},
+		{"partitionNoTest", no, []int{1, 3, 5, 7, 9}, []int{4, 1}},
+		{"filterTest", Filter(ul, even), []int{0, 2, 4, 6, 8}, []int{4, 1}},
+		{"filterNoneTest", Filter(ul, func(v int) bool { return v > 9 }), []int{}, []int{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.list.ExportElems(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("list elements = %v, want %v", got, tt.want)
+			}
+
+			if got := nodeSizes(tt.list); !reflect.DeepEqual(got, tt.wantSizes) {
+				t.Errorf("list node sizes = %v, want %v", got, tt.wantSizes)
+			}
+
+			if err := tt.list.Validate(); err != nil {
+				t.Errorf("list is broken: %v", err)
+			}
+		})
+	}
+
+	if ul.Len() != 10 {
+		t.Errorf("Partition() changed the source list")
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+
+	groups := GroupBy(ul, func(v int) int { return v % 3 })
+
+	want := map[int][]int{
+		0: {0, 3, 6, 9},
+		1: {1, 4, 7},
+		2: {2, 5, 8},
+	}
+
+	if len(groups) != len(want) {
+		t.Fatalf("GroupBy() created %d groups, want %d", len(groups), len(want))
+	}
+
+	for k, vals := range want {
+		if got := groups[k].ExportElems(); !reflect.DeepEqual(got, vals) {
+			t.Errorf("GroupBy() group %v = %v, want %v", k, got, vals)
+		}
+	}
+}
+
+func TestReduce(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+
+	if got := Reduce(ul, 0, func(acc, v int) int { return acc + v }); got != 45 {
+		t.Errorf("Reduce() = %v, want %v", got, 45)
+	}
+
+	if got := Reduce(ul, "", func(acc string, v int) string { return acc + strconv.Itoa(v) }); got != "0123456789" {
+		t.Errorf("Reduce() = %v, want %v", got, "0123456789")
+	}
+}
+
+func TestAnyAllCount(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+	calls := 0
+
+	less := func(n int) func(int) bool {
+		return func(v int) bool {
+			calls++
+			return v < n
+		}
+	}
+
+	tests := []struct {
+		name      string
+		got       func() bool
+		want      bool
+		wantCalls int
+	}{
+		{"anyTest", func() bool { return Any(ul, less(1)) }, true, 1},
+		{"anyNoneTest", func() bool { return Any(ul, less(0)) }, false, 10},
+		{"allTest", func() bool { return All(ul, less(10)) }, true, 10},
+		{"allStopTest", func() bool { return All(ul, less(3)) }, false, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+
+			if got := tt.got(); got != tt.want || calls != tt.wantCalls {
+				t.Errorf("got %v after %d calls, want %v after %d calls", got, calls, tt.want, tt.wantCalls)
+			}
+		})
+	}
+
+	if got := Count(ul, less(4)); got != 4 {
+		t.Errorf("Count() = %v, want %v", got, 4)
+	}
+}
+
+func TestMapWithEqual(t *testing.T) {
+	ul := newSearchTestList(WithEqual(sameID))
+
+	ids := Map(ul, func(v searchTestItem) int { return v.ID })
+
+	// equality function of other type is dropped, == is used
+	if !ids.IsContains(3) {
+		t.Errorf("Map() list does not contain mapped element")
+	}
+
+	if !Filter(ul, func(v searchTestItem) bool { return v.ID > 2 }).IsContains(searchTestItem{ID: 4}) {
+		t.Errorf("Filter() list does not keep equality function")
+	}
+}
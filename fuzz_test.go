@@ -0,0 +1,154 @@
+package goulist
+
+import (
+	"reflect"
+	"testing"
+)
+
+// maxFuzzSteps is max number of operations of one fuzz input.
+const maxFuzzSteps = 500
+
+// fuzzModel is a plain slice model of the list.
+type fuzzModel []interface{}
+
+// nodeStart returns logical index of the first element of the node num.
+func nodeStart(ul *Ulist, num int) int {
+	start := 0
+
+	for node := ul.first; num > 0; node = node.next {
+		start += node.size
+		num--
+	}
+
+	return start
+}
+
+// FuzzUlist drives random sequences of mutations of Ulist and checks them
+// against a slice model. The first two bytes of input choose node capacity
+// and policy, each following step takes three bytes: operation and its
+// arguments. Run it with
+//
+// 	go test -fuzz FuzzUlist
+func FuzzUlist(f *testing.F) {
+	f.Add([]byte{4, 0, 0, 1, 0, 0, 2, 0, 0, 3, 0, 0})
+	f.Add([]byte{4, 1, 0, 9, 0, 0, 9, 0, 1, 2, 1, 0, 3, 0, 1, 4, 0, 5})
+	f.Add([]byte{3, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 5, 0, 0, 6, 1, 1})
+	f.Add([]byte{8, 3, 7, 0, 0, 7, 0, 0, 7, 0, 0, 8, 2, 2, 10, 0, 0, 11, 0, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// long inputs do not find more, but slow fuzzing down
+		if len(data) < 2 || len(data) > 2+3*maxFuzzSteps {
+			return
+		}
+
+		var (
+			c    = int(data[0])%16 + 1
+			opts []Option
+		)
+
+		switch data[1] % 4 {
+		case 1:
+			opts = append(opts, WithMinFill(c/4))
+		case 2:
+			opts = append(opts, WithSplitRatio(0.25))
+		case 3:
+			opts = append(opts, WithAppendOptimizedSplit())
+		}
+
+		var (
+			ul    = NewUlistCustomCap(c, opts...)
+			model = fuzzModel{}
+		)
+
+		for data = data[2:]; len(data) >= 3; data = data[3:] {
+			var (
+				op   = data[0] % 12
+				a, b = int(data[1]), int(data[2])
+				val  = interface{}(a % 8) // small values to repeat
+			)
+
+			switch op {
+			case 0, 1:
+				ul.Push(val)
+				model = append(model, val)
+			case 2:
+				num := a % ul.GetSize()
+				index := nodeStart(ul, num+1)
+
+				if err := ul.Insert(val, num); err != nil {
+					t.Fatalf("Ulist.Insert() error = %v", err)
+				}
+
+				model = append(model[:index], append(fuzzModel{val}, model[index:]...)...)
+			case 3:
+				num := a % ul.GetSize()
+				node, _ := ul.findNode(num)
+				size := node.size
+				err := ul.RemoveFromNode(num, b)
+
+				if (err != nil) != (b >= size) {
+					t.Fatalf("Ulist.RemoveFromNode() error = %v", err)
+				}
+
+				if err == nil {
+					index := nodeStart(ul, num) + b
+					model = append(model[:index], model[index+1:]...)
+				}
+			case 4:
+				ul.RemoveAllOccurrences(val)
+
+				k := 0
+
+				for _, v := range model {
+					if v != val {
+						model[k] = v
+						k++
+					}
+				}
+
+				model = model[:k]
+			case 5:
+				num := a % ul.GetSize()
+				index := nodeStart(ul, num) + b
+
+				if _, err := ul.Set(num, b, val); err == nil {
+					model[index] = val
+				}
+			case 6:
+				if b == 0 {
+					ul.Clear()
+					model = model[:0]
+				}
+			case 7:
+				index := a % (len(model) + 1)
+				ul.InsertAt(index, val)
+				model = append(model[:index], append(fuzzModel{val}, model[index:]...)...)
+			case 8:
+				if len(model) > 0 {
+					index := a % len(model)
+					ul.RemoveAt(index)
+					model = append(model[:index], model[index+1:]...)
+				}
+			case 9:
+				ul.PushFront(val)
+				model = append(fuzzModel{val}, model...)
+			case 10:
+				if _, ok := ul.PopBack(); ok {
+					model = model[:len(model)-1]
+				}
+			case 11:
+				if _, ok := ul.PopFront(); ok {
+					model = model[1:]
+				}
+			}
+
+			if err := ul.Validate(); err != nil {
+				t.Fatalf("Operation %d broke the list: %v", op, err)
+			}
+
+			if got := ul.ExportElems(); !reflect.DeepEqual(got, []interface{}(model)) {
+				t.Fatalf("Operation %d: list = %v, want %v", op, got, model)
+			}
+		}
+	})
+}
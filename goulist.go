@@ -17,6 +17,10 @@
 // 	than half full, then we move all its remaining elements into the
 // 	current node, then bypass and delete it.
 //
+// 	List[T] is the generic form of the list, it stores elements of type T
+// 	in typed node arrays. Ulist is the same list of interface{} elements
+// 	and is kept for compatibility.
+//
 // See http://en.wikipedia.org/wiki/Unrolled_linked_list for details
 package goulist
 
@@ -34,28 +38,59 @@ import (
 // See https://en.wikipedia.org/wiki/CPU_cache for details.
 const CacheLineSize = int(unsafe.Sizeof(cpu.CacheLinePad{}))
 
+// PageSize represents the memory page size of the current system.
+var PageSize = os.Getpagesize()
+
+// MaxNodeCapacity is the max number of elements of each list's node.
+// Greater capacity given to constructors or options is lowered to it, so
+// encoded list is always accepted by decoder, which can not be made to
+// allocate huge nodes by malformed data.
+const MaxNodeCapacity = 1 << 16
+
 // ulistNode is a single node of the unrolled linked list.
-// It contains links to previous and next node, number of stored elements and
-// slice of elements.
-type ulistNode struct {
-	next     *ulistNode
-	prev     *ulistNode
+// It contains links to previous and next node, number of stored elements,
+// slice of elements and split and merge policy of the list.
+type ulistNode[T any] struct {
+	next     *ulistNode[T]
+	prev     *ulistNode[T]
 	size     int // number of elements
 	capacity int // max number of elements
-	elems    []interface{}
+	elems    []T
+	opts     *options // nil for default policy
 }
 
-// newUlistNode creates empty instance of list's node.
-// All elements in empty node is set to nil.
-func newUlistNode(c int) *ulistNode {
-	elems := make([]interface{}, 0)
+// equal checks if given values are equal. It panics if values are
+// not comparable, as == operator does with interface{} values.
+func equal[T any](a, b T) bool {
+	return any(a) == any(b)
+}
 
-	// fill elems field with nil elemets
-	for i := 0; i < c; i++ {
-		elems = append(elems, nil)
+// equals checks if given values are equal using equality function of the
+// list (see WithEqual), or == operator if it is not set.
+func (un *ulistNode[T]) equals(a, b T) bool {
+	if un.opts != nil && un.opts.equal != nil {
+		return un.opts.equal.(func(a, b T) bool)(a, b)
 	}
 
-	return &ulistNode{
+	return equal(a, b)
+}
+
+// equals checks if given values are equal the way the list compares its
+// elements. Nodes linked from another list by Concat or Splice may keep
+// their own options, so searches use function of the first node for all
+// nodes of the list.
+func (ul *List[T]) equals(a, b T) bool {
+	return ul.first.equals(a, b)
+}
+
+// newUlistNode creates empty instance of list's node.
+// All elements in empty node is set to zero value (nil for interface{}).
+// Node's elements occupy the first size places of the elems, so any value,
+// including nil or zero one, can be stored.
+func newUlistNode[T any](c int) *ulistNode[T] {
+	elems := make([]T, c)
+
+	return &ulistNode[T]{
 		next:     nil,
 		prev:     nil,
 		size:     0,
@@ -64,34 +99,79 @@ func newUlistNode(c int) *ulistNode {
 	}
 }
 
-// add sets the first non-nil element equal to the given value
+// newNode creates empty node with the same capacity and policy
+// as the current one.
+func (un *ulistNode[T]) newNode() *ulistNode[T] {
+	node := newUlistNode[T](un.capacity)
+	node.opts = un.opts
+
+	return node
+}
+
+// clone creates unlinked copy of the node with the same capacity, policy
+// and elements.
+func (un *ulistNode[T]) clone() *ulistNode[T] {
+	node := un.newNode()
+	node.size = copy(node.elems, un.elems[:un.size])
+
+	return node
+}
+
+// minFill returns min number of elements of the node, unless it is the last
+// list's node. It is half of node's capacity by default.
+func (un *ulistNode[T]) minFill() int {
+	if un.opts == nil {
+		return un.capacity / 2
+	}
+
+	return un.opts.minFill
+}
+
+// splitPoint returns number of elements kept in the full node when it is split.
+// It is half of node's capacity (rounded up) by default.
+func (un *ulistNode[T]) splitPoint() int {
+	if un.opts == nil {
+		return un.capacity - un.capacity/2
+	}
+
+	return un.opts.split
+}
+
+// add sets the element next to the last occupied one equal to the given value
 // and increments size of node. If the node is full, this function creates
 // a new node and moves to it a number of elements equal to half the
-// length of the cuttent node. in this case, the new element is
-// added to the end of the new node. The function returns a new node,
-// empty if no elements were moved.
-func (un *ulistNode) add(val interface{}) *ulistNode {
-	newNode := newUlistNode(un.capacity)
+// length of the cuttent node (see splitPoint()). in this case, the new element is
+// added to the end of the new node. If the node is the last one and append
+// optimized split is set, no elements are moved, so the full node is left
+// behind. The function returns a new node, or nil if node was not full.
+func (un *ulistNode[T]) add(val T) *ulistNode[T] {
+	var (
+		zero    T
+		newNode *ulistNode[T]
+	)
 
 	if !un.isFull() {
-		for i := range un.elems {
-			if un.elems[i] == nil {
-				un.elems[i] = val
-				break
-			}
-		}
-
+		un.elems[un.size] = val
 		un.size++
 	} else {
-		// elements to move
-		tmv := un.capacity / 2
+		newNode = un.newNode()
+
+		if un.next == nil && un.opts != nil && un.opts.appendSplit {
+			newNode.elems[0] = val
+			newNode.size = 1
+
+			return newNode
+		}
+
 		// element to start moving
-		start := un.capacity - tmv
+		start := un.splitPoint()
+		// elements to move
+		tmv := un.capacity - start
 
 		for i := 0; i < tmv; i++ {
 			newNode.elems[i] = un.elems[start+i]
 			newNode.size++
-			un.elems[start+i] = nil
+			un.elems[start+i] = zero
 			un.size--
 		}
 
@@ -102,27 +182,77 @@ func (un *ulistNode) add(val interface{}) *ulistNode {
 	return newNode
 }
 
-// del removes the element with the given index from the node.
+// insert inserts the given value at the given index of the node, shifting
+// following elements to the end of the node. If the node is full, this function
+// creates a new node and moves to it a number of elements equal to half the
+// length of the current node (see splitPoint()), then inserts the value to the
+// node it belongs to.
+// The function returns a new node or nil if no elements were moved.
+func (un *ulistNode[T]) insert(index int, val T) *ulistNode[T] {
+	var (
+		zero    T
+		newNode *ulistNode[T]
+		target  = un
+	)
+
+	if un.isFull() && index == un.size {
+		return un.add(val)
+	}
+
+	if un.isFull() {
+		newNode = un.newNode()
+
+		// elements to move, at least one to free a place
+		tmv := un.capacity - un.splitPoint()
+
+		if tmv == 0 {
+			tmv = 1
+		}
+
+		// element to start moving
+		start := un.capacity - tmv
+
+		copy(newNode.elems, un.elems[start:])
+		newNode.size = tmv
+
+		for i := start; i < un.capacity; i++ {
+			un.elems[i] = zero
+		}
+
+		un.size = start
+
+		if index > start {
+			target = newNode
+			index -= start
+		}
+	}
+
+	copy(target.elems[index+1:], target.elems[index:target.size])
+	target.elems[index] = val
+	target.size++
+
+	return newNode
+}
+
+// del removes the element with the given index from the node and shifts
+// all following elements to the start of the node.
 // Returns the index on success. In other cases returns zero and error.
-func (un *ulistNode) del(index int) (int, error) {
+func (un *ulistNode[T]) del(index int) (int, error) {
 	var (
-		err error
-		n   = 0
+		err  error
+		zero T
 	)
 
-	if index > un.capacity-1 {
+	if index < 0 || index > un.size-1 {
 		err = errors.New("Element index is out of range")
-		return n, err
+		return 0, err
 	}
 
-	un.elems[index] = nil
+	copy(un.elems[index:], un.elems[index+1:un.size])
 	un.size--
+	un.elems[un.size] = zero
 
-	if un.elems[index] == nil {
-		n = index
-	}
-
-	return n, err
+	return index, err
 }
 
 // delAt removes the element with the given index from the node.
@@ -131,9 +261,9 @@ func (un *ulistNode) del(index int) (int, error) {
 // above half. If this leaves the next node less than half full, then it move all
 // next node's remaining elements into the current node, then delete it.
 // It returns zero if next node was not deleted and 1 in other case. If given
-// index is greater than node's capacity or if there was deletion error,
+// index is out of node's elements range or if there was deletion error,
 // it returns zero and error.
-func (un *ulistNode) delAt(index int) (int, error) {
+func (un *ulistNode[T]) delAt(index int) (int, error) {
 	var (
 		err error
 		n   = 0
@@ -150,8 +280,6 @@ func (un *ulistNode) delAt(index int) (int, error) {
 		return 0, err
 	}
 
-	un.shift()
-
 	k := un.redistribAfterDeletion()
 
 	return k, err
@@ -160,67 +288,80 @@ func (un *ulistNode) delAt(index int) (int, error) {
 // delOccurrences removes all ocurrences of given element val from current node.
 // Returns the number of nodes removed after elements redistribution
 // (see redistribAfterDeletion()).
-func (un *ulistNode) delOccurrences(val interface{}) int {
-	for i := range un.elems {
-		if un.elems[i] == val {
-			un.elems[i] = nil
-			un.size--
+func (un *ulistNode[T]) delOccurrences(val T) int {
+	un.compact(val)
+
+	k := un.redistribAfterDeletion()
+
+	return k
+}
+
+// compact removes all ocurrences of given element val from current node
+// and shifts remaining elements to the start of the node.
+// Returns the number of removed elements.
+func (un *ulistNode[T]) compact(val T) int {
+	return un.compactFunc(func(v T) bool {
+		return un.equals(v, val)
+	})
+}
+
+// compactFunc removes all elements of current node for which del returns
+// true and shifts remaining elements to the start of the node.
+// Returns the number of removed elements.
+func (un *ulistNode[T]) compactFunc(del func(T) bool) int {
+	var (
+		c    = 0
+		zero T
+	)
+
+	for i := 0; i < un.size; i++ {
+		if !del(un.elems[i]) {
+			un.elems[c] = un.elems[i]
+			c++
 		}
 	}
 
-	un.shift()
+	n := un.size - c
 
-	k := un.redistribAfterDeletion()
+	for i := c; i < un.size; i++ {
+		un.elems[i] = zero
+	}
 
-	return k
+	un.size = c
+
+	return n
 }
 
 // redistribAfterDeletion redistributes elements between nodes after deletion of
-// some element. If delet operation reduces the node to less than half-full,
-// then it moves elements from the next node (if that not nil) to fill node back up
-// above half. If this leaves the next node less than half full, then it move all
-// next node's remaining elements into the current node, then delete it.
+// some element. If delet operation reduces the node to less than half-full
+// (see minFill()), then it moves elements from the start of the next node
+// (if that not nil) to fill node back up above half. If this leaves the next
+// node less than half full, then it move all next node's remaining elements
+// into the current node, then delete it. The order of elements is preserved.
 // It returns zero if next node was not deleted and 1 in other case.
-func (un *ulistNode) redistribAfterDeletion() int {
-	var n = 0
+func (un *ulistNode[T]) redistribAfterDeletion() int {
+	var (
+		n = 0
+		m = un.minFill()
+	)
 
-	if un.size < un.capacity/2 {
+	if un.size < m {
 		if un.next != nil {
-			tmv := un.capacity/2 - un.size
-
-			// save node's current size
-			sizeNode := un.size
+			tmv := m - un.size
 
-			// save next node's current size
-			sizeNextNode := un.next.size
-
-			for i := 0; i < tmv; i++ {
-				un.elems[sizeNode+i] = un.next.elems[sizeNextNode-1-i]
-				un.size++
-				un.next.elems[sizeNextNode-1-i] = nil
-				un.next.size--
+			if tmv > un.next.size {
+				tmv = un.next.size
 			}
 
-			if un.next.size < un.capacity/2 {
-
-				// save node's current size
-				newSizeNode := un.size
+			un.moveFromNext(tmv)
 
-				// save next node's current size
-				newSizeNextNode := un.next.size
+			if un.next.size < m {
+				un.moveFromNext(un.next.size)
 
-				for j := 0; j < newSizeNextNode; j++ {
-					if un.next.elems[j] != nil {
-						un.elems[newSizeNode+j] = un.next.elems[newSizeNextNode-1-j]
-						un.size++
-					} else {
-						break
-					}
-				}
+				// bypass the next node
+				un.next = un.next.next
 
-				// if next node exists
-				if un.next.next != nil {
-					un.next = un.next.next
+				if un.next != nil {
 					un.next.prev = un
 				}
 
@@ -233,61 +374,79 @@ func (un *ulistNode) redistribAfterDeletion() int {
 	return n
 }
 
-// shift shifts all non-nil elements to the end of the node.
-func (un *ulistNode) shift() {
-	var c = 0
+// moveFromNext moves first n elements of the next node to the end of
+// current node.
+func (un *ulistNode[T]) moveFromNext(n int) {
+	var (
+		zero T
+		next = un.next
+	)
 
-	for i := 0; i < un.capacity; i++ {
-		if un.elems[i] != nil {
-			un.elems[c] = un.elems[i]
-			c++
-		}
-	}
+	copy(un.elems[un.size:], next.elems[:n])
+	un.size += n
 
-	for c != un.capacity {
-		un.elems[c] = nil
-		c++
+	copy(next.elems, next.elems[n:next.size])
+
+	for i := next.size - n; i < next.size; i++ {
+		next.elems[i] = zero
 	}
+
+	next.size -= n
 }
 
 // do calls function fn on each node's element.
-func (un *ulistNode) do(fn func(*interface{})) {
-	for i := range un.elems {
-		if un.elems[i] != nil {
-			fn(&un.elems[i])
-		} else {
-			break
-		}
+func (un *ulistNode[T]) do(fn func(*T)) {
+	for i := 0; i < un.size; i++ {
+		fn(&un.elems[i])
 	}
 }
 
 // isFull checks if node is full
-func (un *ulistNode) isFull() bool {
+func (un *ulistNode[T]) isFull() bool {
 	return un.size == un.capacity
 }
 
-// Ulist is an unrolled linked list itself.
-// It contains links to first and last nodes and number of nodes.
-type Ulist struct {
-	first *ulistNode
-	last  *ulistNode
-	size  int // number of nodes
+// List is an unrolled linked list itself. It stores elements of type T.
+// It contains links to first and last nodes, number of nodes and
+// number of elements.
+type List[T any] struct {
+	first  *ulistNode[T]
+	last   *ulistNode[T]
+	size   int  // number of nodes
+	length int  // number of elements
+	shared bool // nodes are shared with a snapshot
 }
 
-// NewUlist creates new empty unrolled linked list. It has only one (empty)
-// node which is first and last same time. Returns pointer to empty list.
-func newUlist(c int) *Ulist {
+// Ulist is an unrolled linked list of interface{} elements.
+// It is the same type as List[interface{}], so all of the List methods
+// are available for it.
+type Ulist = List[interface{}]
+
+// newUlist creates new empty unrolled linked list configured by given options.
+// It has only one (empty) node which is first and last same time.
+// Returns pointer to empty list.
+func newUlist[T any](c int, opts ...Option) *List[T] {
+	c = min(c, MaxNodeCapacity)
+	o := newOptions(c, unsafe.Sizeof(*new(T)), opts)
+
+	if o != nil {
+		c = o.capacity
+
+		if _, ok := o.equal.(func(a, b T) bool); o.equal != nil && !ok {
+			panic("goulist: WithEqual function does not match element type")
+		}
+	}
+
 	var (
-		ul   = &Ulist{}
-		node = newUlistNode(c)
+		ul   = &List[T]{}
+		node = newUlistNode[T](c)
 	)
 
+	node.opts = o
+
 	ul.first = node
 	ul.last = ul.first
 
-	ul.first.next = ul.last
-	ul.last.prev = ul.first
-
 	ul.size = 1
 
 	return ul
@@ -295,25 +454,59 @@ func newUlist(c int) *Ulist {
 
 // NewUlist creates new empty unrolled linked list. It has only one (empty)
 // node which is first and last same time. Elem fields of list's nodes
-// have length equal to CacheLineSize. Returns pointer to empty list.
-func NewUlist() *Ulist {
-	return newUlist(CacheLineSize)
+// have length equal to CacheLineSize, unless WithCapacity option is given.
+// Note that it is the number of elements, not bytes: interface{} element takes
+// 16 bytes on 64-bit systems, so use WithNodeBytes(CacheLineSize) to fit
+// node's elements into a single cache line. Returns pointer to empty list.
+func NewUlist(opts ...Option) *Ulist {
+	return newUlist[interface{}](CacheLineSize, opts...)
 }
 
 // NewUlistCustomCap creates new empty unrolled linked list.
-// Elem fields of list's nodes have length equal c. Returns pointer to empty list.
-func NewUlistCustomCap(c int) *Ulist {
-	return newUlist(c)
+// Elem fields of list's nodes have length equal c, but no more than
+// MaxNodeCapacity. Returns pointer to empty list.
+func NewUlistCustomCap(c int, opts ...Option) *Ulist {
+	return newUlist[interface{}](c, opts...)
+}
+
+// NewList creates new empty unrolled linked list of elements of type T.
+// It has only one (empty) node which is first and last same time.
+// Elem fields of list's nodes have length equal to CacheLineSize,
+// unless WithCapacity option is given. Returns pointer to empty list.
+func NewList[T any](opts ...Option) *List[T] {
+	return newUlist[T](CacheLineSize, opts...)
+}
+
+// NewListCustomCap creates new empty unrolled linked list of elements of type T.
+// Elem fields of list's nodes have length equal c, but no more than
+// MaxNodeCapacity. Returns pointer to empty list.
+func NewListCustomCap[T any](c int, opts ...Option) *List[T] {
+	return newUlist[T](c, opts...)
+}
+
+// NewListBytes creates new empty unrolled linked list of elements of type T.
+// Elem fields of list's nodes have length such that they take no more
+// than b bytes (see WithNodeBytes), e.g. one or a few cache lines or a page.
+// Returns pointer to empty list.
+func NewListBytes[T any](b int, opts ...Option) *List[T] {
+	opts = append([]Option{WithNodeBytes(b)}, opts...)
+
+	return newUlist[T](CacheLineSize, opts...)
+}
+
+// NodeCapacity returns max number of elements of each list's node.
+func (ul *List[T]) NodeCapacity() int {
+	return ul.first.capacity
 }
 
 // GetSize returns number of list's nodes
-func (ul *Ulist) GetSize() int {
+func (ul *List[T]) GetSize() int {
 	return ul.size
 }
 
-// GetFirst returns slice filled with all list's first node non-nil elements.
-func (ul *Ulist) GetFirst() []interface{} {
-	var s = []interface{}{}
+// GetFirst returns slice filled with all list's first node elements.
+func (ul *List[T]) GetFirst() []T {
+	var s = []T{}
 
 	for i := 0; i < ul.first.size; i++ {
 		s = append(s, ul.first.elems[i])
@@ -322,9 +515,9 @@ func (ul *Ulist) GetFirst() []interface{} {
 	return s
 }
 
-// GetLast returns slice filled with all list's last node non-nil elements.
-func (ul *Ulist) GetLast() []interface{} {
-	var s = []interface{}{}
+// GetLast returns slice filled with all list's last node elements.
+func (ul *List[T]) GetLast() []T {
+	var s = []T{}
 
 	for i := 0; i < ul.last.size; i++ {
 		s = append(s, ul.last.elems[i])
@@ -335,14 +528,14 @@ func (ul *Ulist) GetLast() []interface{} {
 
 // findNode finds node with given index num. If num is greater than half-size of
 // list, search starts from first node. Else search starts from last node.
-// If num is greater than node size, it returns empty node and error.
-func (ul *Ulist) findNode(num int) (*ulistNode, error) {
+// If num is out of list's nodes range, it returns empty node and error.
+func (ul *List[T]) findNode(num int) (*ulistNode[T], error) {
 	var (
 		err     error
-		newNode = &ulistNode{}
+		newNode = &ulistNode[T]{}
 	)
 
-	if num > ul.GetSize() {
+	if num < 0 || num > ul.GetSize()-1 {
 		err = errors.New("Node index is out of range")
 		return newNode, err
 	}
@@ -377,14 +570,16 @@ func (ul *Ulist) findNode(num int) (*ulistNode, error) {
 
 // Push appends new element val to the end of list.
 // Returns the error on failure.
-func (ul *Ulist) Push(val interface{}) error {
+func (ul *List[T]) Push(val T) error {
 	var (
 		err error
 	)
 
+	ul.unshare()
+
 	newNode := ul.last.add(val)
 
-	if newNode.size != 0 {
+	if newNode != nil {
 		// link new node and list's last node
 		ul.last.next = newNode
 		newNode.prev = ul.last
@@ -396,7 +591,9 @@ func (ul *Ulist) Push(val interface{}) error {
 		ul.size++
 	}
 
-	if ul.last.elems[ul.last.size-1] != val {
+	ul.length++
+
+	if ul.last.size == 0 {
 		err = errors.New("Element adding error")
 	}
 
@@ -409,12 +606,14 @@ func (ul *Ulist) Push(val interface{}) error {
 // New element val will be added to the end of new node. New node
 // will be inserted to list after target node. Function returns error if given index
 // is greater than node.capacity.
-func (ul *Ulist) Insert(val interface{}, num int) error {
+func (ul *List[T]) Insert(val T, num int) error {
 	var (
-		targetNode = &ulistNode{}
+		targetNode = &ulistNode[T]{}
 		err        error
 	)
 
+	ul.unshare()
+
 	targetNode, err = ul.findNode(num)
 
 	if err != nil {
@@ -423,20 +622,104 @@ func (ul *Ulist) Insert(val interface{}, num int) error {
 
 	newNode := targetNode.add(val)
 
-	if newNode.size != 0 {
-		targetNode.next.prev = newNode
-		newNode.next = targetNode.next
-		newNode.prev = targetNode
-		ul.size++
+	if newNode != nil {
+		ul.linkAfter(targetNode, newNode)
 	}
 
+	ul.length++
+
 	return err
 }
 
+// linkAfter links new node newNode into the list after node.
+func (ul *List[T]) linkAfter(node, newNode *ulistNode[T]) {
+	ul.linkChainAfter(node, newNode, newNode, 1)
+}
+
+// linkChainAfter links chain of n new nodes from first to last into the list
+// after node.
+func (ul *List[T]) linkChainAfter(node, first, last *ulistNode[T], n int) {
+	first.prev = node
+	last.next = node.next
+
+	if node.next != nil {
+		node.next.prev = last
+	} else {
+		ul.last = last
+	}
+
+	node.next = first
+	ul.size += n
+}
+
+// unlink removes node from the list. Links of the node itself are kept,
+// so the list can be walked further from it.
+func (ul *List[T]) unlink(node *ulistNode[T]) {
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		ul.first = node.next
+	}
+
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		ul.last = node.prev
+	}
+
+	ul.size--
+}
+
+// afterDeletion updates list's size and last node after deletion of elements
+// from the node, which caused removal of n nodes following it. If the node
+// became empty, it is removed from the list, unless it is the only one.
+func (ul *List[T]) afterDeletion(node *ulistNode[T], n int) {
+	ul.size -= n
+
+	if node.next == nil {
+		ul.last = node
+	}
+
+	if node.size == 0 && ul.size > 1 {
+		ul.unlink(node)
+	}
+}
+
+// rebalance removes empty nodes from the list and redistributes elements
+// between remaining nodes after deletion of elements from some of them.
+func (ul *List[T]) rebalance() {
+	for node := ul.first; node != nil; node = node.next {
+		if node.size == 0 && ul.size > 1 {
+			ul.unlink(node)
+		}
+	}
+
+	node := ul.first
+
+	for node != nil {
+		k := node.redistribAfterDeletion()
+
+		if k != 0 {
+			// merged elements may leave the node under-filled still
+			ul.afterDeletion(node, k)
+			continue
+		}
+
+		node = node.next
+	}
+}
+
 // Do calls function fn on each list's element.
-func (ul *Ulist) Do(fn func(*interface{})) {
+func (ul *List[T]) Do(fn func(*T)) {
+	ul.unshare()
+	ul.do(fn)
+}
+
+// do calls function fn on each list's element. Unlike Do, it does not copy
+// nodes shared with a snapshot, so fn must not change elements.
+func (ul *List[T]) do(fn func(*T)) {
 	var (
-		newNode = newUlistNode(ul.first.capacity)
+		newNode = newUlistNode[T](ul.first.capacity)
 		count   = 0
 	)
 
@@ -450,69 +733,60 @@ func (ul *Ulist) Do(fn func(*interface{})) {
 }
 
 // Print prints each list's element.
-func (ul *Ulist) Print() {
-	fn := func(i *interface{}) {
+func (ul *List[T]) Print() {
+	fn := func(i *T) {
 		fmt.Printf("%v\n", *i)
 	}
 
-	ul.Do(fn)
+	ul.do(fn)
 }
 
 // Printc (Print custom) prints each list's element to given io,Writer w.
-// It calls fmt.Errorf and os.Exit(1) in case of error.
-func (ul *Ulist) Printc(w io.Writer) {
-	fn := func(i *interface{}) {
+// It prints error to os.Stderr and calls os.Exit(1) in case of error.
+func (ul *List[T]) Printc(w io.Writer) {
+	fn := func(i *T) {
 		_, err := fmt.Fprintf(w, "%v\n", *i)
 
 		if err != nil {
-			fmt.Errorf("Error of writing to %v\n", w)
+			fmt.Fprintf(os.Stderr, "Error of writing to %v\n", w)
 			os.Exit(1)
 		}
 	}
 
-	ul.Do(fn)
+	ul.do(fn)
 }
 
-// Clear sets all list's element to nil.
-func (ul *Ulist) Clear() {
-	fn := func(i *interface{}) {
-		*i = nil
-	}
-
-	ul.Do(fn)
+// Clear removes all list's elements. The list has only one (empty) node after it.
+func (ul *List[T]) Clear() {
+	ul.first = ul.first.newNode()
+	ul.last = ul.first
+	ul.size = 1
+	ul.length = 0
+	ul.shared = false
 }
 
 // ExportElems returns slice filled with all list's elements.
-func (ul *Ulist) ExportElems() []interface{} {
-	var target = []interface{}{}
+func (ul *List[T]) ExportElems() []T {
+	var target = []T{}
 
-	fn := func(i *interface{}) {
+	fn := func(i *T) {
 		target = append(target, *i)
 	}
 
-	ul.Do(fn)
+	ul.do(fn)
 
 	return target
 }
 
 // IsContains returns true if list contains at least one element val.
-func (ul *Ulist) IsContains(val interface{}) bool {
-	var check = false
-
-	fn := func(i *interface{}) {
-		if val == *i {
-			check = true
-		}
-	}
-
-	ul.Do(fn)
-
-	return check
+// It stops at the first occurrence of val.
+func (ul *List[T]) IsContains(val T) bool {
+	return ul.IndexOf(val) >= 0
 }
 
 // IsContainsAll returns true if this list contains all of the elements
 // of the given slice.
-func (ul *Ulist) IsContainsAll(vals []interface{}) bool {
+func (ul *List[T]) IsContainsAll(vals []T) bool {
 	var check = true
 
 	for i := range vals {
@@ -526,86 +800,79 @@ func (ul *Ulist) IsContainsAll(vals []interface{}) bool {
 }
 
 // PushAll appends all of the elements of the given slice vals to the end of
-// the list, in the original order. Returns error on failure.
-func (ul *Ulist) PushAll(vals []interface{}) error {
-	var (
-		err error
-	)
-
-	for i := range vals {
-		err = ul.Push(vals[i])
-
-		if err != nil {
-			break
-		}
-	}
+// the list, in the original order. It fills the last node, then creates fully
+// packed nodes for the rest of elements. Returns error on failure.
+func (ul *List[T]) PushAll(vals []T) error {
+	ul.unshare()
 
-	return err
+	return ul.pushAll(vals, ul.last.capacity)
 }
 
-// RemoveInNode removes element with index elemNum from node with index nodeNum.
-// Returns error if node's index is greater than list's size.
-func (ul *Ulist) RemoveFromNode(nodeNum, elemNum int) error {
+// RemoveFromNode removes element with index elemNum from node with index nodeNum.
+// Returns error if node's or element's index is out of range.
+func (ul *List[T]) RemoveFromNode(nodeNum, elemNum int) error {
 	var (
 		err  error
 		n    int
-		node = &ulistNode{}
+		node = &ulistNode[T]{}
 	)
 
+	ul.unshare()
+
 	node, err = ul.findNode(nodeNum)
 
+	if err != nil {
+		return err
+	}
+
 	n, err = node.delAt(elemNum)
 
-	if n != 0 {
-		ul.size -= n
+	if err != nil {
+		return err
 	}
 
+	ul.length--
+	ul.afterDeletion(node, n)
+
 	return err
 }
 
 // RemoveAllOccurrences removes all occurrences of element val from list.
-func (ul *Ulist) RemoveAllOccurrences(val interface{}) {
-	var (
-		newNode = newUlistNode(ul.first.capacity)
-		count   = 0
-		s       = ul.GetSize()
-		m       = 0
-	)
-
-	newNode = ul.first
+// Elements are removed from all nodes first, then they are redistributed
+// between nodes, so elements moved between nodes are checked too.
+func (ul *List[T]) RemoveAllOccurrences(val T) {
+	ul.unshare()
 
-	for count < s {
-		k := newNode.delOccurrences(val)
-
-		if k != 0 {
-			m++
-			s--
-		}
-
-		newNode = newNode.next
-		count++
+	for node := ul.first; node != nil; node = node.next {
+		ul.length -= node.compactFunc(func(v T) bool {
+			return ul.equals(v, val)
+		})
 	}
 
-	if m != 0 {
-		ul.size -= m
-	}
+	ul.rebalance()
 }
 
 // RemoveAllOfSlice removes all elements of given slice vals from the list.
-func (ul *Ulist) RemoveAllOfSlice(vals []interface{}) {
-	for i := range vals {
-		ul.RemoveAllOccurrences(vals[i])
-	}
+func (ul *List[T]) RemoveAllOfSlice(vals []T) {
+	ul.RemoveAllOfSliceFunc(vals, ul.equals)
 }
 
 // Set replaces the element at index elemNum in node with index nodeNum
 // with given element val. Returns new value of the element
-// and error if node's index is greater than list's size.
-func (ul *Ulist) Set(nodeNum, elemNum int, val interface{}) (interface{}, error) {
+// and error if node's or element's index is out of range.
+func (ul *List[T]) Set(nodeNum, elemNum int, val T) (T, error) {
+	var zero T
+
+	ul.unshare()
+
 	node, err := ul.findNode(nodeNum)
 
 	if err != nil {
-		return nil, err
+		return zero, err
+	}
+
+	if elemNum < 0 || elemNum > node.size-1 {
+		return zero, errors.New("Element index is out of range")
 	}
 
 	node.elems[elemNum] = val
@@ -613,32 +880,24 @@ func (ul *Ulist) Set(nodeNum, elemNum int, val interface{}) (interface{}, error)
 	return node.elems[elemNum], err
 }
 
-// Len returns number of all non-nil elements stored in list
-func (ul *Ulist) Len() int {
-	var (
-		l       = 0
-		count   = 0
-		newNode = newUlistNode(ul.first.capacity)
-	)
-
-	newNode = ul.first
-
-	for count < ul.GetSize() {
-		l += newNode.size
-		newNode = newNode.next
-		count++
-	}
-
-	return l
+// Len returns number of all elements stored in list
+func (ul *List[T]) Len() int {
+	return ul.length
 }
 
 // Get returns element stored at the index elemNum in node with index nodeNum
-// and error if node's index is greater than list's size.
-func (ul *Ulist) Get(nodeNum, elemNum int) (interface{}, error) {
+// and error if node's or element's index is out of range.
+func (ul *List[T]) Get(nodeNum, elemNum int) (T, error) {
+	var zero T
+
 	node, err := ul.findNode(nodeNum)
 
 	if err != nil {
-		return nil, err
+		return zero, err
+	}
+
+	if elemNum < 0 || elemNum > node.size-1 {
+		return zero, errors.New("Element index is out of range")
 	}
 
 	return node.elems[elemNum], err
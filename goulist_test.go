@@ -12,9 +12,33 @@ var (
 	nodeSize = 4
 )
 
+// newRangeList creates list with node capacity c configured by given options
+// and pushes elements from, from+1, ..., to-1 to it one by one, so nodes
+// are split as they are by appending.
+func newRangeList(c, from, to int, opts ...Option) *List[int] {
+	ul := NewListCustomCap[int](c, opts...)
+
+	for i := from; i < to; i++ {
+		ul.Push(i)
+	}
+
+	return ul
+}
+
+// intRange returns slice of elements from, from+1, ..., to-1.
+func intRange(from, to int) []int {
+	vals := []int{}
+
+	for i := from; i < to; i++ {
+		vals = append(vals, i)
+	}
+
+	return vals
+}
+
 func Test_newUlistNode(t *testing.T) {
 	var (
-		newNode = &ulistNode{nil, nil, 0, nodeSize, []interface{}{nil, nil, nil, nil}}
+		newNode = &ulistNode[interface{}]{nil, nil, 0, nodeSize, []interface{}{nil, nil, nil, nil}, nil}
 	)
 
 	type args struct {
@@ -24,15 +48,15 @@ func Test_newUlistNode(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want *ulistNode
+		want *ulistNode[interface{}]
 	}{
 		{"newNodeTest", args{nodeSize}, newNode},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := newUlistNode(tt.args.c); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("newUlistNode() = %v, want %v", got, tt.want)
+			if got := newUlistNode[interface{}](tt.args.c); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newUlistNode[interface{}]() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -40,55 +64,54 @@ func Test_newUlistNode(t *testing.T) {
 
 // TODO: refactoring
 func Test_ulistNode_add(t *testing.T) {
-	var (
-		node = newUlistNode(nodeSize)
-	)
-
 	var (
 		toAdd       = 555
 		toAffIfFull = 333
 	)
 
 	var (
-		nodeAfter = &ulistNode{
+		nodeAfter = &ulistNode[interface{}]{
 			nil,
 			nil,
 			1,
 			nodeSize,
 			[]interface{}{toAdd, nil, nil, nil},
+			nil,
 		}
 	)
 
 	var (
-		halfFullNode = &ulistNode{nil,
+		halfFullNode = &ulistNode[interface{}]{nil,
 			nil,
 			3,
 			nodeSize,
 			[]interface{}{toAdd, toAdd, toAffIfFull, nil},
+			nil,
 		}
 	)
 
 	var (
-		halfFullNodeAfter = &ulistNode{
+		halfFullNodeAfter = &ulistNode[interface{}]{
 			nil,
 			nil,
 			2,
 			nodeSize,
 			[]interface{}{toAdd, toAdd, toAffIfFull, nil},
+			nil,
 		}
 	)
 
 	type fields struct {
-		next     *ulistNode
-		prev     *ulistNode
+		next     *ulistNode[interface{}]
+		prev     *ulistNode[interface{}]
 		size     int
 		capacity int
 		elems    []interface{}
 	}
 
 	type newFields struct {
-		next     *ulistNode
-		prev     *ulistNode
+		next     *ulistNode[interface{}]
+		prev     *ulistNode[interface{}]
 		size     int
 		capacity int
 		elems    []interface{}
@@ -103,8 +126,8 @@ func Test_ulistNode_add(t *testing.T) {
 		fields    fields
 		newFields newFields
 		args      args
-		want      *ulistNode // returned node
-		self      *ulistNode // node itself after adding
+		want      *ulistNode[interface{}] // returned node
+		self      *ulistNode[interface{}] // node itself after adding
 	}{
 		{
 			// test case of adding to empty node
@@ -112,7 +135,7 @@ func Test_ulistNode_add(t *testing.T) {
 			fields{nil, nil, 0, nodeSize, []interface{}{nil, nil, nil, nil}},
 			newFields{nil, nil, 1, nodeSize, []interface{}{toAdd, nil, nil, nil}},
 			args{toAdd},
-			node,
+			nil, // no new node if the node is not full
 			nodeAfter,
 		},
 
@@ -136,7 +159,7 @@ func Test_ulistNode_add(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// node itself
-			un := &ulistNode{
+			un := &ulistNode[interface{}]{
 				next:     tt.fields.next,
 				prev:     tt.fields.prev,
 				size:     tt.fields.size,
@@ -145,7 +168,7 @@ func Test_ulistNode_add(t *testing.T) {
 			}
 
 			// returned node
-			nn := &ulistNode{
+			nn := &ulistNode[interface{}]{
 				next:     tt.newFields.next,
 				prev:     tt.newFields.prev,
 				size:     tt.newFields.size,
@@ -164,14 +187,69 @@ func Test_ulistNode_add(t *testing.T) {
 	}
 }
 
+func Test_ulistNode_insert(t *testing.T) {
+	tests := []struct {
+		name    string
+		elems   []interface{}
+		size    int
+		index   int
+		want    []interface{}
+		wantNew []interface{} // nil if node must not be split
+	}{
+		{
+			"insertNotFullTest",
+			[]interface{}{1, 2, nil, nil},
+			2,
+			1,
+			[]interface{}{1, 555, 2, nil},
+			nil,
+		},
+
+		{
+			"insertFullFirstHalfTest",
+			[]interface{}{1, 2, 3, 4},
+			4,
+			0,
+			[]interface{}{555, 1, 2, nil},
+			[]interface{}{3, 4, nil, nil},
+		},
+
+		{
+			"insertFullSecondHalfTest",
+			[]interface{}{1, 2, 3, 4},
+			4,
+			3,
+			[]interface{}{1, 2, nil, nil},
+			[]interface{}{3, 555, 4, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			un := &ulistNode[interface{}]{nil, nil, tt.size, nodeSize, tt.elems, nil}
+
+			got := un.insert(tt.index, 555)
+
+			if !reflect.DeepEqual(un.elems, tt.want) {
+				t.Errorf("ulistNode.insert() elems = %v, want %v", un.elems, tt.want)
+			}
+
+			if (got == nil) != (tt.wantNew == nil) ||
+				(got != nil && !reflect.DeepEqual(got.elems, tt.wantNew)) {
+				t.Errorf("ulistNode.insert() = %v, want %v", got, tt.wantNew)
+			}
+		})
+	}
+}
+
 func Test_ulistNode_del(t *testing.T) {
 	var (
 		errn = errors.New("Element index is out of range")
 	)
 
 	type fields struct {
-		next     *ulistNode
-		prev     *ulistNode
+		next     *ulistNode[interface{}]
+		prev     *ulistNode[interface{}]
 		size     int
 		capacity int
 		elems    []interface{}
@@ -218,7 +296,7 @@ func Test_ulistNode_del(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			un := &ulistNode{
+			un := &ulistNode[interface{}]{
 				next:     tt.fields.next,
 				prev:     tt.fields.prev,
 				size:     tt.fields.size,
@@ -237,11 +315,13 @@ func Test_ulistNode_del(t *testing.T) {
 				t.Errorf("ulistNode.del() = %v, want %v", got, tt.want)
 			}
 
-			if (err == nil && tt.args.index == 0) && (un.elems[0] != nil) {
+			if (err == nil && tt.args.index == 0) &&
+				(un.elems[0] != 2 || un.elems[1] != nil || un.size != 1) {
 				t.Errorf("ulistNode.del() error: elements mismatch")
 			}
 
-			if (err == nil && tt.args.index == 1) && (un.elems[1] != nil) {
+			if (err == nil && tt.args.index == 1) &&
+				(un.elems[0] != 1 || un.elems[1] != nil || un.size != 1) {
 				t.Errorf("ulistNode.del() error: elements mismatch")
 			}
 		})
@@ -250,16 +330,16 @@ func Test_ulistNode_del(t *testing.T) {
 
 func Test_ulistNode_delAt(t *testing.T) {
 	var (
-		node2 = &ulistNode{nil, nil, 2, nodeSize, []interface{}{3, 4, nil, nil}}
-		node3 = &ulistNode{nil, nil, 2, nodeSize, []interface{}{5, 6, nil, nil}}
+		node2 = &ulistNode[interface{}]{nil, nil, 2, nodeSize, []interface{}{3, 4, nil, nil}, nil}
+		node3 = &ulistNode[interface{}]{nil, nil, 2, nodeSize, []interface{}{5, 6, nil, nil}, nil}
 	)
 
 	node2.next = node3
 	node3.prev = node2
 
 	type fields struct {
-		next     *ulistNode
-		prev     *ulistNode
+		next     *ulistNode[interface{}]
+		prev     *ulistNode[interface{}]
 		size     int
 		capacity int
 		elems    []interface{}
@@ -296,7 +376,7 @@ func Test_ulistNode_delAt(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			un := &ulistNode{
+			un := &ulistNode[interface{}]{
 				next:     tt.fields.next,
 				prev:     tt.fields.prev,
 				size:     tt.fields.size,
@@ -329,7 +409,7 @@ func Test_ulistNode_delAt(t *testing.T) {
 			}
 
 			if ((err == nil && got == tt.want) && (tt.args.index == 0)) &&
-				((un.elems[0] != 2) || (un.elems[1] != 4) || (un.elems[2] != 3)) {
+				((un.elems[0] != 2) || (un.elems[1] != 3) || (un.elems[2] != 4)) {
 				t.Errorf("Order of ulistNode.elems is wrong")
 			}
 		})
@@ -338,16 +418,16 @@ func Test_ulistNode_delAt(t *testing.T) {
 
 func Test_ulistNode_delOccurrences(t *testing.T) {
 	var (
-		node2 = &ulistNode{nil, nil, 2, nodeSize, []interface{}{3, 4, nil, nil}}
-		node3 = &ulistNode{nil, nil, 2, nodeSize, []interface{}{5, 6, nil, nil}}
+		node2 = &ulistNode[interface{}]{nil, nil, 2, nodeSize, []interface{}{3, 4, nil, nil}, nil}
+		node3 = &ulistNode[interface{}]{nil, nil, 2, nodeSize, []interface{}{5, 6, nil, nil}, nil}
 	)
 
 	node2.next = node3
 	node3.prev = node2
 
 	type fields struct {
-		next     *ulistNode
-		prev     *ulistNode
+		next     *ulistNode[interface{}]
+		prev     *ulistNode[interface{}]
 		size     int
 		capacity int
 		elems    []interface{}
@@ -373,7 +453,7 @@ func Test_ulistNode_delOccurrences(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			un := &ulistNode{
+			un := &ulistNode[interface{}]{
 				next:     tt.fields.next,
 				prev:     tt.fields.prev,
 				size:     tt.fields.size,
@@ -386,55 +466,51 @@ func Test_ulistNode_delOccurrences(t *testing.T) {
 			}
 
 			if un.delOccurrences(tt.args.val); un.elems[0] != 2 ||
-				un.elems[1] != 4 || un.elems[2] != 3 {
+				un.elems[1] != 3 || un.elems[2] != 4 {
 				t.Errorf("Order of ulistNode.elems is wrong after deletion")
 			}
 		})
 	}
 }
 
-func Test_ulistNode_shift(t *testing.T) {
-	type fields struct {
-		next     *ulistNode
-		prev     *ulistNode
-		size     int
-		capacity int
-		elems    []interface{}
-	}
-
+func Test_ulistNode_compact(t *testing.T) {
 	tests := []struct {
-		name   string
-		fields fields
+		name  string
+		elems []interface{}
+		size  int
+		val   interface{}
+		want  []interface{}
+		n     int
 	}{
 		{
-			"shiftTest1",
-			fields{nil, nil, 2, nodeSize, []interface{}{3, nil, 4, nil}},
+			"compactTest",
+			[]interface{}{3, 4, 3, nil},
+			3,
+			3,
+			[]interface{}{4, nil, nil, nil},
+			2,
 		},
 
 		{
-			"shiftTest2",
-			fields{nil, nil, 1, nodeSize, []interface{}{nil, nil, 4, nil}},
+			"compactNilTest",
+			[]interface{}{nil, 4, nil, 5},
+			4,
+			nil,
+			[]interface{}{4, 5, nil, nil},
+			2,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			un := &ulistNode{
-				next:     tt.fields.next,
-				prev:     tt.fields.prev,
-				size:     tt.fields.size,
-				capacity: tt.fields.capacity,
-				elems:    tt.fields.elems,
-			}
-
-			un.shift()
+			un := &ulistNode[interface{}]{nil, nil, tt.size, nodeSize, tt.elems, nil}
 
-			if un.size == 2 && (un.elems[1] != 4 || un.elems[2] != nil) {
-				t.Errorf("Wrong elements order")
+			if got := un.compact(tt.val); got != tt.n {
+				t.Errorf("ulistNode.compact() = %v, want %v", got, tt.n)
 			}
 
-			if un.size == 1 && (un.elems[0] != 4 || un.elems[2] != nil) {
-				t.Errorf("Wrong elements order")
+			if !reflect.DeepEqual(un.elems, tt.want) || un.size != tt.size-tt.n {
+				t.Errorf("ulistNode.compact() elems = %v, want %v", un.elems, tt.want)
 			}
 		})
 	}
@@ -446,8 +522,8 @@ func Test_ulistNode_do(t *testing.T) {
 	}
 
 	type fields struct {
-		next     *ulistNode
-		prev     *ulistNode
+		next     *ulistNode[interface{}]
+		prev     *ulistNode[interface{}]
 		size     int
 		capacity int
 		elems    []interface{}
@@ -471,7 +547,7 @@ func Test_ulistNode_do(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			un := &ulistNode{
+			un := &ulistNode[interface{}]{
 				next:     tt.fields.next,
 				prev:     tt.fields.prev,
 				size:     tt.fields.size,
@@ -496,8 +572,8 @@ func Test_ulistNode_do(t *testing.T) {
 
 func Test_ulistNode_isFull(t *testing.T) {
 	type fields struct {
-		next     *ulistNode
-		prev     *ulistNode
+		next     *ulistNode[interface{}]
+		prev     *ulistNode[interface{}]
 		size     int
 		capacity int
 		elems    []interface{}
@@ -523,7 +599,7 @@ func Test_ulistNode_isFull(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			un := &ulistNode{
+			un := &ulistNode[interface{}]{
 				next:     tt.fields.next,
 				prev:     tt.fields.prev,
 				size:     tt.fields.size,
@@ -563,7 +639,7 @@ func Test_newUlist(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := newUlist(tt.args.c)
+			got := newUlist[interface{}](tt.args.c)
 
 			if got.size != tt.wantSize {
 				t.Errorf("Ulist size = %d but %d needed", got.size, tt.wantSize)
@@ -617,7 +693,7 @@ func TestNewUlist(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := newUlist(tt.args.c)
+			got := newUlist[interface{}](tt.args.c)
 
 			if got.size != tt.wantSize {
 				t.Errorf("Ulist size = %d but %d needed", got.size, tt.wantSize)
@@ -670,7 +746,7 @@ func TestNewUlistCustomCap(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := newUlist(tt.args.c)
+			got := newUlist[interface{}](tt.args.c)
 
 			if got.size != tt.wantSize {
 				t.Errorf("Ulist size = %d but %d needed", got.size, tt.wantSize)
@@ -700,8 +776,8 @@ func TestNewUlistCustomCap(t *testing.T) {
 
 func TestUlist_GetSize(t *testing.T) {
 	type fields struct {
-		first *ulistNode
-		last  *ulistNode
+		first *ulistNode[interface{}]
+		last  *ulistNode[interface{}]
 		size  int
 	}
 
@@ -712,7 +788,7 @@ func TestUlist_GetSize(t *testing.T) {
 	}{
 		{
 			"ulistGetSizeTest",
-			fields{&ulistNode{}, &ulistNode{}, 2},
+			fields{&ulistNode[interface{}]{}, &ulistNode[interface{}]{}, 2},
 			2,
 		},
 	}
@@ -963,6 +1039,10 @@ func TestUlist_Push(t *testing.T) {
 					t.Errorf("Wrong size of list's last node")
 				}
 			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("Ulist.Push() broke list's structure: %v", err)
+			}
 		})
 	}
 }
@@ -1008,6 +1088,9 @@ func TestUlist_Insert(t *testing.T) {
 				t.Errorf("Ulist.Insert() error = %v, wantErr %v", err, tt.wantErr)
 			}
 
+			if err := ul.Validate(); err != nil {
+				t.Errorf("Ulist.Insert() broke list's structure: %v", err)
+			}
 		})
 	}
 }
@@ -1149,6 +1232,10 @@ func TestUlist_PushAll(t *testing.T) {
 			if !reflect.DeepEqual(ul.last.elems, tt.want) {
 				t.Errorf("Some elements was not added")
 			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("Ulist.PushAll() broke list's structure: %v", err)
+			}
 		})
 	}
 }
@@ -1160,7 +1247,7 @@ func TestUlist_RemoveFromNode(t *testing.T) {
 		ul.Push(i)
 	}
 
-	n := []interface{}{3, 5, 4, nil}
+	n := []interface{}{3, 4, 5, nil}
 
 	type args struct {
 		nodeNum int
@@ -1214,6 +1301,10 @@ func TestUlist_RemoveFromNode(t *testing.T) {
 					tt.wantErr,
 				)
 			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("Ulist.RemoveFromNode() broke list's structure: %v", err)
+			}
 		})
 	}
 }
@@ -1251,6 +1342,10 @@ func TestUlist_RemoveAllOccurrences(t *testing.T) {
 			if !reflect.DeepEqual(ul.first.elems, tt.want) {
 				t.Errorf("Error of element %d deletion", tt.args.val)
 			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("Ulist.RemoveAllOccurrences() broke list's structure: %v", err)
+			}
 		})
 	}
 }
@@ -1290,6 +1385,10 @@ func TestUlist_RemoveAllOfSlice(t *testing.T) {
 			if !reflect.DeepEqual(ul.first.elems, tt.want) {
 				t.Errorf("Error of element %d deletion", tt.args.vals)
 			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("Ulist.RemoveAllOfSlice() broke list's structure: %v", err)
+			}
 		})
 	}
 }
@@ -1381,6 +1480,10 @@ func TestUlist_Set(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Ulist.Set() = %v, want %v", got, tt.want)
 			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("Ulist.Set() broke list's structure: %v", err)
+			}
 		})
 	}
 }
@@ -1494,6 +1597,10 @@ func TestUlist_Do(t *testing.T) {
 					)
 				}
 			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("Ulist.Do() broke list's structure: %v", err)
+			}
 		})
 	}
 }
@@ -1522,6 +1629,10 @@ func TestUlist_Clear(t *testing.T) {
 					t.Errorf("Clear() test failed")
 				}
 			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("Ulist.Clear() broke list's structure: %v", err)
+			}
 		})
 	}
 }
@@ -1552,3 +1663,193 @@ func TestUlist_Printc(t *testing.T) {
 		})
 	}
 }
+
+func TestNewList(t *testing.T) {
+	got := NewList[int]()
+
+	if got.size != 1 {
+		t.Errorf("List size = %d but %d needed", got.size, 1)
+	}
+
+	if len(got.first.elems) != CacheLineSize {
+		t.Errorf(
+			"List node length = %d, but %d needed",
+			len(got.first.elems), CacheLineSize)
+	}
+
+	if got.first.size != 0 {
+		t.Errorf("List node size = %d, but %d needed", got.first.size, 0)
+	}
+}
+
+func TestNewListCustomCap(t *testing.T) {
+	got := NewListCustomCap[string](nodeSize)
+
+	if got.size != 1 {
+		t.Errorf("List size = %d but %d needed", got.size, 1)
+	}
+
+	if !reflect.DeepEqual(got.first.elems, []string{"", "", "", ""}) {
+		t.Errorf("All new list's elements must be empty strings")
+	}
+}
+
+func TestList_Typed(t *testing.T) {
+	ul := NewListCustomCap[int](nodeSize)
+
+	for i := 1; i < 8; i++ {
+		if err := ul.Push(i); err != nil {
+			t.Fatalf("List.Push() error = %v", err)
+		}
+	}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5, 6, 7}) {
+		t.Errorf("List.ExportElems() = %v", got)
+	}
+
+	if got, err := ul.Get(1, 0); err != nil || got != 3 {
+		t.Errorf("List.Get() = %v, %v, want %v", got, err, 3)
+	}
+
+	if got, err := ul.Set(1, 0, 33); err != nil || got != 33 {
+		t.Errorf("List.Set() = %v, %v, want %v", got, err, 33)
+	}
+
+	if err := ul.Insert(44, 0); err != nil {
+		t.Errorf("List.Insert() error = %v", err)
+	}
+
+	if got := ul.GetFirst(); !reflect.DeepEqual(got, []int{1, 2, 44}) {
+		t.Errorf("List.GetFirst() = %v", got)
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("List.Insert() broke list's structure: %v", err)
+	}
+
+	ul.RemoveAllOccurrences(44)
+
+	if ul.IsContains(44) {
+		t.Errorf("List.RemoveAllOccurrences() did not remove %d", 44)
+	}
+
+	sum := 0
+
+	ul.Do(func(i *int) {
+		sum += *i
+	})
+
+	if sum != 1+2+33+4+5+6+7 {
+		t.Errorf("List.Do() sum = %d", sum)
+	}
+
+	if err := ul.RemoveFromNode(0, 0); err != nil {
+		t.Errorf("List.RemoveFromNode() error = %v", err)
+	}
+
+	if ul.Len() != 6 || ul.IsContains(1) {
+		t.Errorf("List.RemoveFromNode() did not remove %d", 1)
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("List.RemoveFromNode() broke list's structure: %v", err)
+	}
+}
+
+func TestUlist_NilElements(t *testing.T) {
+	ul := NewUlistCustomCap(nodeSize)
+
+	for i := 0; i < 10; i++ {
+		if i%2 == 0 {
+			ul.Push(nil)
+		} else {
+			ul.Push(i)
+		}
+	}
+
+	want := []interface{}{nil, 1, nil, 3, nil, 5, nil, 7, nil, 9}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) || ul.Len() != 10 {
+		t.Fatalf("Ulist.Push() of nil elements = %v, want %v", got, want)
+	}
+
+	// first node is {nil, 1}, so nil is added to the end of it
+	if err := ul.Insert(nil, 0); err != nil {
+		t.Fatalf("Ulist.Insert() error = %v", err)
+	}
+
+	want = []interface{}{nil, 1, nil, nil, 3, nil, 5, nil, 7, nil, 9}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Ulist.Insert() of nil element = %v, want %v", got, want)
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("Ulist.Insert() broke list's structure: %v", err)
+	}
+
+	// removing of element causes redistribution of nil elements
+	if err := ul.RemoveFromNode(0, 1); err != nil {
+		t.Fatalf("Ulist.RemoveFromNode() error = %v", err)
+	}
+
+	want = []interface{}{nil, nil, nil, 3, nil, 5, nil, 7, nil, 9}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) || ul.Len() != 10 {
+		t.Fatalf("Ulist.RemoveFromNode() = %v, want %v", got, want)
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("Ulist.RemoveFromNode() broke list's structure: %v", err)
+	}
+
+	if got, err := ul.Get(0, 0); err != nil || got != nil {
+		t.Errorf("Ulist.Get() = %v, %v, want nil element", got, err)
+	}
+
+	if !ul.IsContains(nil) {
+		t.Errorf("Ulist.IsContains() = false, want true")
+	}
+
+	ul.RemoveAllOccurrences(nil)
+
+	want = []interface{}{3, 5, 7, 9}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Ulist.RemoveAllOccurrences() = %v, want %v", got, want)
+	}
+
+	if got := ul.GetLast(); ul.last.next != nil || !reflect.DeepEqual(got, []interface{}{7, 9}) {
+		t.Errorf("Ulist.GetLast() = %v after removing, want %v", got, []interface{}{7, 9})
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("Ulist.RemoveAllOccurrences() broke list's structure: %v", err)
+	}
+}
+
+func TestList_ZeroElements(t *testing.T) {
+	ul := NewListCustomCap[int](nodeSize)
+
+	ul.PushAll([]int{0, 0, 1, 0, 0, 2, 0})
+
+	count := 0
+
+	ul.Do(func(i *int) {
+		count++
+	})
+
+	if count != 7 || ul.Len() != 7 {
+		t.Errorf("List.Do() visited %d elements, want %d", count, 7)
+	}
+
+	ul.RemoveAllOccurrences(0)
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("List.RemoveAllOccurrences() = %v, want %v", got, []int{1, 2})
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("List.RemoveAllOccurrences() broke list's structure: %v", err)
+	}
+}
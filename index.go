@@ -0,0 +1,126 @@
+package goulist
+
+import (
+	"errors"
+)
+
+// findElem finds node containing element with the given logical index
+// and index of the element in that node. If index is less than half-length
+// of list, search starts from first node. Else search starts from last node.
+// If index is out of list's elements range, it returns nil node and error.
+func (ul *List[T]) findElem(index int) (*ulistNode[T], int, error) {
+	if index < 0 || index > ul.length-1 {
+		return nil, 0, errors.New("Element index is out of range")
+	}
+
+	// start from front
+	if index < ul.length/2 {
+		node := ul.first
+
+		for index >= node.size {
+			index -= node.size
+			node = node.next
+		}
+
+		return node, index, nil
+	}
+
+	// start from back, count elements from the end of the list
+	node := ul.last
+	r := ul.length - 1 - index
+
+	for r >= node.size {
+		r -= node.size
+		node = node.prev
+	}
+
+	return node, node.size - 1 - r, nil
+}
+
+// At returns element with the given logical index, counted over all
+// list's elements, and error if index is out of range.
+func (ul *List[T]) At(index int) (T, error) {
+	var zero T
+
+	node, i, err := ul.findElem(index)
+
+	if err != nil {
+		return zero, err
+	}
+
+	return node.elems[i], err
+}
+
+// SetAt replaces element with the given logical index with given element val.
+// Returns new value of the element and error if index is out of range.
+func (ul *List[T]) SetAt(index int, val T) (T, error) {
+	var zero T
+
+	ul.unshare()
+
+	node, i, err := ul.findElem(index)
+
+	if err != nil {
+		return zero, err
+	}
+
+	node.elems[i] = val
+
+	return node.elems[i], err
+}
+
+// InsertAt inserts a new element val before element with the given logical
+// index, so val gets that index. If index is equal to list's length, val
+// is appended to the end of list. If target node is full, it is split
+// in two halves. Returns error if index is out of range.
+func (ul *List[T]) InsertAt(index int, val T) error {
+	if index == ul.length {
+		return ul.Push(val)
+	}
+
+	ul.unshare()
+
+	node, i, err := ul.findElem(index)
+
+	if err != nil {
+		return err
+	}
+
+	newNode := node.insert(i, val)
+
+	if newNode != nil {
+		ul.linkAfter(node, newNode)
+	}
+
+	ul.length++
+
+	return err
+}
+
+// RemoveAt removes element with the given logical index from the list
+// and redistributes elements between nodes if needed.
+// Returns removed element and error if index is out of range.
+func (ul *List[T]) RemoveAt(index int) (T, error) {
+	var zero T
+
+	ul.unshare()
+
+	node, i, err := ul.findElem(index)
+
+	if err != nil {
+		return zero, err
+	}
+
+	val := node.elems[i]
+
+	n, err := node.delAt(i)
+
+	if err != nil {
+		return zero, err
+	}
+
+	ul.length--
+	ul.afterDeletion(node, n)
+
+	return val, err
+}
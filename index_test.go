@@ -0,0 +1,198 @@
+package goulist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestList_findElem(t *testing.T) {
+	// nodes are {0, 1} {2, 3} {4, 5} {6, 7, 8, 9}
+	ul := newRangeList(nodeSize, 0, 10)
+
+	tests := []struct {
+		name     string
+		index    int
+		wantElem int
+		wantErr  bool
+	}{
+		{"findElemFirstTest", 0, 0, false},
+		{"findElemFrontTest", 3, 3, false},
+		{"findElemBackTest", 6, 6, false},
+		{"findElemLastTest", 9, 9, false},
+		{"findElemNegativeTest", -1, 0, true},
+		{"findElemOutOfRangeTest", 10, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, i, err := ul.findElem(tt.index)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("List.findElem() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err == nil && node.elems[i] != tt.wantElem {
+				t.Errorf("List.findElem() = %v, want %v", node.elems[i], tt.wantElem)
+			}
+		})
+	}
+}
+
+func TestList_At(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 23)
+
+	for i := 0; i < 23; i++ {
+		if got, err := ul.At(i); err != nil || got != i {
+			t.Errorf("List.At(%d) = %v, %v, want %v", i, got, err, i)
+		}
+	}
+
+	if _, err := ul.At(23); err == nil {
+		t.Errorf("List.At() error = nil for index out of range")
+	}
+}
+
+func TestList_SetAt(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+
+	tests := []struct {
+		name    string
+		index   int
+		val     int
+		wantErr bool
+	}{
+		{"setAtTest", 5, 55, false},
+		{"setAtWithErrorTest", 567, 55, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ul.SetAt(tt.index, tt.val)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("List.SetAt() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			if v, _ := ul.At(tt.index); got != tt.val || v != tt.val {
+				t.Errorf("List.SetAt() = %v, want %v", got, tt.val)
+			}
+		})
+	}
+}
+
+func TestList_InsertAt(t *testing.T) {
+	tests := []struct {
+		name    string
+		index   int
+		want    []int
+		wantErr bool
+	}{
+		{"insertAtStartTest", 0, []int{99, 0, 1, 2, 3, 4}, false},
+		{"insertAtMiddleTest", 3, []int{0, 1, 2, 99, 3, 4}, false},
+		{"insertAtEndTest", 5, []int{0, 1, 2, 3, 4, 99}, false},
+		{"insertAtWithErrorTest", 6, []int{0, 1, 2, 3, 4}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ul := newRangeList(nodeSize, 0, 5)
+
+			if err := ul.InsertAt(tt.index, 99); (err != nil) != tt.wantErr {
+				t.Errorf("List.InsertAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got := ul.ExportElems(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("List.InsertAt() = %v, want %v", got, tt.want)
+			}
+
+			if ul.Len() != len(tt.want) || ul.last.next != nil {
+				t.Errorf("List.InsertAt() broke list's structure")
+			}
+		})
+	}
+}
+
+func TestList_InsertAtFullNodes(t *testing.T) {
+	ul := NewListCustomCap[int](nodeSize)
+	want := []int{}
+
+	// every insertion in the middle of full node splits it
+	for i := 0; i < 50; i++ {
+		index := (i * 7) % (len(want) + 1)
+
+		want = append(want[:index], append([]int{i}, want[index:]...)...)
+
+		if err := ul.InsertAt(index, i); err != nil {
+			t.Fatalf("List.InsertAt() error = %v", err)
+		}
+
+		if err := ul.Validate(); err != nil {
+			t.Fatalf("List.Validate() error = %v", err)
+		}
+	}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List.InsertAt() = %v, want %v", got, want)
+	}
+}
+
+func TestList_RemoveAt(t *testing.T) {
+	tests := []struct {
+		name    string
+		index   int
+		wantVal int
+		want    []int
+		wantErr bool
+	}{
+		{"removeAtStartTest", 0, 0, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, false},
+		{"removeAtMiddleTest", 4, 4, []int{0, 1, 2, 3, 5, 6, 7, 8, 9}, false},
+		{"removeAtEndTest", 9, 9, []int{0, 1, 2, 3, 4, 5, 6, 7, 8}, false},
+		{"removeAtWithErrorTest", 10, 0, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ul := newRangeList(nodeSize, 0, 10)
+
+			got, err := ul.RemoveAt(tt.index)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("List.RemoveAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.wantVal {
+				t.Errorf("List.RemoveAt() = %v, want %v", got, tt.wantVal)
+			}
+
+			if elems := ul.ExportElems(); !reflect.DeepEqual(elems, tt.want) {
+				t.Errorf("List.RemoveAt() elements = %v, want %v", elems, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_RemoveAtAll(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 30)
+
+	for i := 0; i < 30; i++ {
+		index := (i * 5) % ul.Len()
+
+		if _, err := ul.RemoveAt(index); err != nil {
+			t.Fatalf("List.RemoveAt() error = %v", err)
+		}
+
+		if err := ul.Validate(); err != nil {
+			t.Fatalf("List.Validate() error = %v", err)
+		}
+	}
+
+	if ul.Len() != 0 || ul.GetSize() != 1 || ul.first != ul.last {
+		t.Errorf("List is not empty after removing of all elements")
+	}
+}
@@ -0,0 +1,113 @@
+package goulist
+
+import (
+	"iter"
+)
+
+// All returns an iterator over logical indexes and list's elements,
+// from the first to the last one. Loop over it can be stopped with break:
+//
+// 	for i, v := range list.All() {
+// 		fmt.Println(i, v)
+// 	}
+func (ul *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+
+		for node := ul.first; node != nil; node = node.next {
+			for j := 0; j < node.size; j++ {
+				if !yield(i, node.elems[j]) {
+					return
+				}
+
+				i++
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over logical indexes and list's elements,
+// from the last to the first one. It walks nodes from the list's last node
+// via their links to previous nodes.
+func (ul *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := ul.length - 1
+
+		for node := ul.last; node != nil; node = node.prev {
+			for j := node.size - 1; j >= 0; j-- {
+				if !yield(i, node.elems[j]) {
+					return
+				}
+
+				i--
+			}
+		}
+	}
+}
+
+// Values returns an iterator over list's elements, from the first
+// to the last one.
+func (ul *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := ul.first; node != nil; node = node.next {
+			for j := 0; j < node.size; j++ {
+				if !yield(node.elems[j]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Chunks returns an iterator over list's nodes. It yields slice of
+// elements of each node. Slices share storage with the nodes, so elements
+// may be changed through them, but slices must not be kept or appended to
+// after the list is changed. Nodes shared with a snapshot are copied before
+// the first slice is yielded (see Snapshot), so changes do not reach it.
+func (ul *List[T]) Chunks() iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		ul.unshare()
+		ul.chunks()(yield)
+	}
+}
+
+// chunks returns an iterator over list's nodes like Chunks. Unlike Chunks,
+// it does not copy nodes shared with a snapshot, so slices must not be
+// changed.
+func (ul *List[T]) chunks() iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for node := ul.first; node != nil; node = node.next {
+			if node.size == 0 {
+				continue
+			}
+
+			if !yield(node.elems[:node.size:node.size]) {
+				return
+			}
+		}
+	}
+}
+
+// AppendSeq appends all of the elements of the given iterator seq to the end
+// of the list, in the original order.
+func (ul *List[T]) AppendSeq(seq iter.Seq[T]) {
+	for v := range seq {
+		ul.Push(v)
+	}
+}
+
+// Collect creates new unrolled linked list filled with elements of the given
+// iterator seq. Elem fields of list's nodes have length equal to CacheLineSize.
+func Collect[T any](seq iter.Seq[T]) *List[T] {
+	return CollectCustomCap(seq, CacheLineSize)
+}
+
+// CollectCustomCap creates new unrolled linked list filled with elements of the
+// given iterator seq. Elem fields of list's nodes have length equal c.
+func CollectCustomCap[T any](seq iter.Seq[T], c int) *List[T] {
+	ul := newUlist[T](c)
+
+	ul.AppendSeq(seq)
+
+	return ul
+}
@@ -0,0 +1,111 @@
+package goulist
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestList_All(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 11)
+	got := []int{}
+
+	for i, v := range ul.All() {
+		if i != v {
+			t.Errorf("List.All() index = %d for element %d", i, v)
+		}
+
+		if v == 7 {
+			break
+		}
+
+		got = append(got, v)
+	}
+
+	if want := []int{0, 1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List.All() = %v, want %v", got, want)
+	}
+}
+
+func TestList_Backward(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 11)
+	got := []int{}
+
+	for i, v := range ul.Backward() {
+		if i != v {
+			t.Errorf("List.Backward() index = %d for element %d", i, v)
+		}
+
+		if v == 3 {
+			break
+		}
+
+		got = append(got, v)
+	}
+
+	if want := []int{10, 9, 8, 7, 6, 5, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List.Backward() = %v, want %v", got, want)
+	}
+}
+
+func TestList_Values(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 11)
+
+	if got := slices.Collect(ul.Values()); !reflect.DeepEqual(got, ul.ExportElems()) {
+		t.Errorf("List.Values() = %v, want %v", got, ul.ExportElems())
+	}
+
+	for v := range ul.Values() {
+		if v != 0 {
+			t.Errorf("List.Values() did not stop at break")
+		}
+
+		break
+	}
+}
+
+func TestList_Chunks(t *testing.T) {
+	// nodes are {0, 1} {2, 3} {4, 5, 6}
+	ul := newRangeList(nodeSize, 0, 7)
+	got := [][]int{}
+
+	for c := range ul.Chunks() {
+		got = append(got, c)
+	}
+
+	if want := [][]int{{0, 1}, {2, 3}, {4, 5, 6}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List.Chunks() = %v, want %v", got, want)
+	}
+
+	for range NewList[int]().Chunks() {
+		t.Errorf("List.Chunks() yielded chunk of empty list")
+	}
+}
+
+func TestCollect(t *testing.T) {
+	want := []string{"a", "b", "c"}
+
+	ul := Collect(slices.Values(want))
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Collect() = %v, want %v", got, want)
+	}
+
+	if len(ul.first.elems) != CacheLineSize {
+		t.Errorf("Collect() node length = %d, want %d", len(ul.first.elems), CacheLineSize)
+	}
+}
+
+func TestCollectCustomCap(t *testing.T) {
+	src := newRangeList(nodeSize, 0, 9)
+
+	ul := CollectCustomCap(src.Values(), 3)
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, src.ExportElems()) {
+		t.Errorf("CollectCustomCap() = %v, want %v", got, src.ExportElems())
+	}
+
+	if len(ul.first.elems) != 3 {
+		t.Errorf("CollectCustomCap() node length = %d, want %d", len(ul.first.elems), 3)
+	}
+}
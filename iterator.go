@@ -0,0 +1,260 @@
+package goulist
+
+import (
+	"errors"
+)
+
+// Iterator is a bidirectional cursor over list's elements. It holds the node
+// and the index of the current element in it, so moving and editing through
+// it do not require search of the node. Iterator stays valid across edits
+// made through it. Edits made to the list by other means invalidate it.
+//
+// New iterator is positioned before the first element, so the list can be
+// walked as follows:
+//
+// 	it := list.Iterator()
+//
+// 	for it.Next() {
+// 		fmt.Println(it.Value())
+// 	}
+type Iterator[T any] struct {
+	list  *List[T]
+	node  *ulistNode[T] // nil if iterator is out of the list
+	index int           // index of the current element in the node
+	pos   int           // logical index of the current element in the list
+}
+
+// Iterator returns new iterator positioned before the first list's element.
+func (ul *List[T]) Iterator() *Iterator[T] {
+	return &Iterator[T]{
+		list: ul,
+		pos:  -1,
+	}
+}
+
+// IteratorEnd returns new iterator positioned after the last list's element.
+// It is used to walk the list backwards with Prev.
+func (ul *List[T]) IteratorEnd() *Iterator[T] {
+	return &Iterator[T]{
+		list: ul,
+		pos:  ul.length,
+	}
+}
+
+// Next moves iterator to the next element. It returns false if there is no
+// next element, in this case iterator is positioned after the last element.
+func (it *Iterator[T]) Next() bool {
+	if it.node == nil {
+		if it.pos >= it.list.length {
+			return false
+		}
+
+		it.node = it.list.first
+		it.index = -1
+	}
+
+	it.index++
+	it.pos++
+
+	for it.node != nil && it.index > it.node.size-1 {
+		it.node = it.node.next
+		it.index = 0
+	}
+
+	if it.node == nil {
+		it.pos = it.list.length
+		return false
+	}
+
+	return true
+}
+
+// Prev moves iterator to the previous element. It returns false if there is no
+// previous element, in this case iterator is positioned before the first element.
+func (it *Iterator[T]) Prev() bool {
+	if it.node == nil {
+		if it.pos < 0 {
+			return false
+		}
+
+		it.node = it.list.last
+		it.index = it.list.last.size
+	}
+
+	it.index--
+	it.pos--
+
+	for it.node != nil && it.index < 0 {
+		it.node = it.node.prev
+
+		if it.node != nil {
+			it.index = it.node.size - 1
+		}
+	}
+
+	if it.node == nil {
+		it.pos = -1
+		return false
+	}
+
+	return true
+}
+
+// Valid returns true if iterator is positioned at some element.
+func (it *Iterator[T]) Valid() bool {
+	return it.node != nil
+}
+
+// Index returns logical index of the current element in the list.
+// It returns -1 or list's length if iterator is positioned before the first
+// or after the last element.
+func (it *Iterator[T]) Index() int {
+	return it.pos
+}
+
+// Value returns current element. It returns zero value (nil for interface{})
+// if iterator is not positioned at some element.
+func (it *Iterator[T]) Value() T {
+	var zero T
+
+	if it.node == nil {
+		return zero
+	}
+
+	return it.node.elems[it.index]
+}
+
+// Set replaces current element with given element val.
+// Returns error if iterator is not positioned at some element.
+func (it *Iterator[T]) Set(val T) error {
+	if it.node == nil {
+		return errors.New("Iterator is out of range")
+	}
+
+	it.unshare()
+
+	it.node.elems[it.index] = val
+
+	return nil
+}
+
+// Seek moves iterator to the element with given logical index.
+// Returns error if index is out of range, iterator is not moved in this case.
+func (it *Iterator[T]) Seek(index int) error {
+	node, i, err := it.list.findElem(index)
+
+	if err != nil {
+		return err
+	}
+
+	it.node = node
+	it.index = i
+	it.pos = index
+
+	return err
+}
+
+// InsertBefore inserts a new element val before the current element.
+// Iterator stays at the current element. Returns error if iterator
+// is not positioned at some element.
+func (it *Iterator[T]) InsertBefore(val T) error {
+	if it.node == nil {
+		return errors.New("Iterator is out of range")
+	}
+
+	it.unshare()
+	it.insert(it.index, val)
+	it.pos++
+
+	return nil
+}
+
+// InsertAfter inserts a new element val after the current element.
+// Iterator stays at the current element. Returns error if iterator
+// is not positioned at some element.
+func (it *Iterator[T]) InsertAfter(val T) error {
+	if it.node == nil {
+		return errors.New("Iterator is out of range")
+	}
+
+	it.unshare()
+	it.insert(it.index+1, val)
+
+	return nil
+}
+
+// unshare copies list's nodes shared with a snapshot before a change made
+// through iterator, and moves iterator to the copy of the current element.
+func (it *Iterator[T]) unshare() {
+	if it.list.unshare() {
+		it.node, it.index, _ = it.list.findElem(it.pos)
+	}
+}
+
+// insert inserts val at the index i of the current node and moves iterator
+// to the place of the current element, which may be moved by node's split.
+func (it *Iterator[T]) insert(i int, val T) {
+	var (
+		node  = it.node
+		index = it.index
+	)
+
+	if i <= index {
+		index++
+	}
+
+	newNode := node.insert(i, val)
+
+	if newNode != nil {
+		it.list.linkAfter(node, newNode)
+
+		if index > node.size-1 {
+			it.node = newNode
+			index -= node.size
+		}
+	}
+
+	it.index = index
+	it.list.length++
+}
+
+// Remove removes the current element and moves iterator to the next one.
+// Returns removed element and error if iterator is not positioned
+// at some element.
+func (it *Iterator[T]) Remove() (T, error) {
+	var zero T
+
+	if it.node == nil {
+		return zero, errors.New("Iterator is out of range")
+	}
+
+	it.unshare()
+
+	var (
+		node = it.node
+		val  = node.elems[it.index]
+	)
+
+	n, err := node.delAt(it.index)
+
+	if err != nil {
+		return zero, err
+	}
+
+	it.list.length--
+	it.list.afterDeletion(node, n)
+
+	// elements following the removed one are shifted to its place,
+	// unless the removed one was the last element of the node. Links
+	// of the node are kept even if it was removed from the list.
+	if it.index > node.size-1 {
+		it.node = node.next
+		it.index = 0
+	}
+
+	if it.node == nil {
+		it.pos = it.list.length
+	}
+
+	return val, err
+}
@@ -0,0 +1,203 @@
+package goulist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestList_Iterator(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 11)
+
+	var (
+		it   = ul.Iterator()
+		got  = []int{}
+		want = []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	)
+
+	if it.Valid() || it.Index() != -1 {
+		t.Errorf("New iterator must be positioned before the first element")
+	}
+
+	for it.Next() {
+		if it.Index() != len(got) {
+			t.Errorf("Iterator.Index() = %d, want %d", it.Index(), len(got))
+		}
+
+		got = append(got, it.Value())
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Iterator.Next() walked %v, want %v", got, want)
+	}
+
+	if it.Valid() || it.Index() != ul.Len() || it.Next() {
+		t.Errorf("Iterator must be positioned after the last element")
+	}
+
+	got = []int{}
+
+	for it.Prev() {
+		got = append([]int{it.Value()}, got...)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Iterator.Prev() walked %v, want %v", got, want)
+	}
+}
+
+func TestList_IteratorEnd(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{"iteratorEndEmptyTest", 0, []int{}},
+		{"iteratorEndTest", 5, []int{4, 3, 2, 1, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				it  = newRangeList(nodeSize, 0, tt.n).IteratorEnd()
+				got = []int{}
+			)
+
+			for it.Prev() {
+				got = append(got, it.Value())
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Iterator.Prev() walked %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIterator_Seek(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 20)
+	it := ul.Iterator()
+
+	tests := []struct {
+		name    string
+		index   int
+		wantErr bool
+	}{
+		{"seekFrontTest", 3, false},
+		{"seekBackTest", 17, false},
+		{"seekWithErrorTest", 20, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := it.Seek(tt.index); (err != nil) != tt.wantErr {
+				t.Errorf("Iterator.Seek() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (it.Value() != tt.index || it.Index() != tt.index) {
+				t.Errorf("Iterator.Seek() moved to %d, want %d", it.Value(), tt.index)
+			}
+		})
+	}
+}
+
+func TestIterator_Set(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+	it := ul.Iterator()
+
+	if err := it.Set(1); err == nil {
+		t.Errorf("Iterator.Set() error = nil before the first element")
+	}
+
+	for it.Next() {
+		it.Set(it.Value() * 10)
+	}
+
+	want := []int{0, 10, 20, 30, 40, 50, 60, 70, 80, 90}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Iterator.Set() = %v, want %v", got, want)
+	}
+}
+
+func TestIterator_Insert(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+	it := ul.Iterator()
+	want := []int{}
+
+	if err := it.InsertAfter(1); err == nil {
+		t.Errorf("Iterator.InsertAfter() error = nil before the first element")
+	}
+
+	// insert negative copy of each element around it, splitting full nodes
+	for it.Next() {
+		v := it.Value()
+
+		if err := it.InsertBefore(-v); err != nil {
+			t.Fatalf("Iterator.InsertBefore() error = %v", err)
+		}
+
+		if err := it.InsertAfter(-v); err != nil {
+			t.Fatalf("Iterator.InsertAfter() error = %v", err)
+		}
+
+		if it.Value() != v || it.Index() != len(want)+1 {
+			t.Fatalf("Iterator moved from %d to %d after insertion", v, it.Value())
+		}
+
+		if err := ul.Validate(); err != nil {
+			t.Fatalf("List.Validate() error = %v", err)
+		}
+
+		// skip inserted element
+		it.Next()
+
+		want = append(want, -v, v, -v)
+	}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) || ul.Len() != len(want) {
+		t.Errorf("Iterator insertion = %v, want %v", got, want)
+	}
+}
+
+func TestIterator_Remove(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 20)
+	it := ul.Iterator()
+	want := []int{}
+
+	if _, err := it.Remove(); err == nil {
+		t.Errorf("Iterator.Remove() error = nil before the first element")
+	}
+
+	it.Next()
+
+	// remove every element divisible by 3
+	for it.Valid() {
+		v := it.Value()
+
+		if v%3 != 0 {
+			want = append(want, v)
+			it.Next()
+
+			continue
+		}
+
+		got, err := it.Remove()
+
+		if err != nil || got != v {
+			t.Fatalf("Iterator.Remove() = %v, %v, want %v", got, err, v)
+		}
+
+		if err := ul.Validate(); err != nil {
+			t.Fatalf("List.Validate() error = %v", err)
+		}
+	}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) || ul.Len() != len(want) {
+		t.Errorf("Iterator removal = %v, want %v", got, want)
+	}
+
+	if it.Index() != ul.Len() {
+		t.Errorf("Iterator must be positioned after the last element")
+	}
+}
@@ -0,0 +1,138 @@
+package goulist
+
+import (
+	"bytes"
+	"encoding/json"
+	"unsafe"
+)
+
+// jsonLayout is a JSON document with node layout of the list.
+type jsonLayout[T any] struct {
+	Capacity int           `json:"capacity"`
+	Nodes    [][]T         `json:"nodes"`
+	Policy   *binaryPolicy `json:"policy,omitempty"` // nil for default policy
+}
+
+// MarshalJSON implements json.Marshaler. It encodes the list as a plain
+// JSON array of elements, zero List is encoded as an empty array. If list
+// is created with WithJSONLayout option, it encodes the list as a document
+// with node capacity and array of elements of each node:
+//
+// 	{"capacity": 4, "nodes": [[1, 2], [3, 4, 5]]}
+//
+// Split and merge policy is written to the document too, unless it is
+// the default one:
+//
+// 	{"capacity": 4, "nodes": [[1], [2, 3]], "policy": {"minFill": 1, "split": 2}}
+func (ul *List[T]) MarshalJSON() ([]byte, error) {
+	if ul.first == nil {
+		return []byte("[]"), nil
+	}
+
+	if ul.first.opts == nil || !ul.first.opts.jsonLayout {
+		return json.Marshal(ul.ExportElems())
+	}
+
+	return ul.MarshalJSONLayout()
+}
+
+// MarshalJSONLayout encodes the list as a JSON document with node capacity,
+// array of elements of each node and policy (see MarshalJSON), regardless
+// of WithJSONLayout option.
+// Zero List is encoded as an empty node of capacity CacheLineSize.
+func (ul *List[T]) MarshalJSONLayout() ([]byte, error) {
+	if ul.first == nil {
+		return json.Marshal(jsonLayout[T]{Capacity: CacheLineSize, Nodes: [][]T{{}}})
+	}
+
+	jl := jsonLayout[T]{
+		Capacity: ul.first.capacity,
+		Nodes:    make([][]T, 0, ul.size),
+	}
+
+	for node := ul.first; node != nil; node = node.next {
+		jl.Nodes = append(jl.Nodes, node.elems[:node.size])
+	}
+
+	c := jl.Capacity
+
+	if p := ul.first.policy(); p != (policy{minFill: c / 2, split: c - c/2}) {
+		jl.Policy = &binaryPolicy{
+			MinFill:     p.minFill,
+			Split:       p.split,
+			AppendSplit: p.appendSplit,
+		}
+	}
+
+	return json.Marshal(jl)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It replaces list's content
+// with elements of the JSON array, which are packed into nodes of list's
+// capacity (CacheLineSize for zero List). Document with node layout (see
+// MarshalJSON) is decoded with its capacity, nodes and policy, list is
+// encoded with node layout after it. Elements are decoded into type T, so
+// elements of Ulist are decoded as encoding/json does it for interface{}
+// values.
+// Returns error if data is malformed (see restore), list is not changed
+// in this case.
+func (ul *List[T]) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '{' {
+		var jl jsonLayout[T]
+
+		if err := json.Unmarshal(data, &jl); err != nil {
+			return err
+		}
+
+		opts := newOptions(jl.Capacity, unsafe.Sizeof(*new(T)), []Option{WithJSONLayout()})
+
+		if p := jl.Policy; p != nil {
+			p.JSONLayout = true
+
+			var err error
+
+			if opts, err = p.options(jl.Capacity); err != nil {
+				return err
+			}
+		}
+
+		return ul.restore(jl.Capacity, jl.Nodes, opts)
+	}
+
+	var vals []T
+
+	if err := json.Unmarshal(data, &vals); err != nil {
+		return err
+	}
+
+	var (
+		c    = CacheLineSize
+		opts *options
+	)
+
+	if ul.first != nil {
+		c = ul.first.capacity
+		opts = ul.first.opts
+	}
+
+	return ul.restore(c, splitSlice(vals, c), opts)
+}
+
+// splitSlice splits slice vals to parts with c elements each, the last part
+// gets the rest of elements. It returns one empty part for empty slice.
+func splitSlice[T any](vals []T, c int) [][]T {
+	parts := [][]T{}
+
+	for len(vals) > c {
+		parts = append(parts, vals[:c])
+		vals = vals[c:]
+	}
+
+	return append(parts, vals)
+}
@@ -0,0 +1,306 @@
+package goulist
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestList_MarshalJSON(t *testing.T) {
+	var (
+		plain  = newRangeList(nodeSize, 0, 7)
+		layout = NewListCustomCap[int](nodeSize, WithJSONLayout())
+		empty  = NewListCustomCap[int](nodeSize)
+		ulist  = NewUlistCustomCap(nodeSize)
+	)
+
+	for i := 0; i < 7; i++ {
+		layout.Push(i)
+	}
+
+	ulist.PushAll([]interface{}{"a", nil, 1.5})
+
+	tests := []struct {
+		name string
+		ul   json.Marshaler
+		want string
+	}{
+		{"marshalJSONTest", plain, `[0,1,2,3,4,5,6]`},
+		{"marshalJSONLayoutTest", layout, `{"capacity":4,"nodes":[[0,1],[2,3],[4,5,6]]}`},
+		{"marshalJSONEmptyTest", empty, `[]`},
+		{"marshalJSONZeroListTest", &List[int]{}, `[]`},
+		{"marshalJSONUlistTest", ulist, `["a",null,1.5]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.ul.MarshalJSON()
+
+			if err != nil {
+				t.Fatalf("List.MarshalJSON() error = %v", err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("List.MarshalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_MarshalJSONZeroField(t *testing.T) {
+	type doc struct {
+		L List[int]
+	}
+
+	got, err := json.Marshal(&doc{})
+
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	if want := `{"L":[]}`; string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+
+	var d doc
+
+	if err := json.Unmarshal(got, &d); err != nil || d.L.Len() != 0 {
+		t.Errorf("json.Unmarshal() error = %v, length %d", err, d.L.Len())
+	}
+
+	if err := d.L.Validate(); err != nil {
+		t.Errorf("json.Unmarshal() broke list's structure: %v", err)
+	}
+
+	want := fmt.Sprintf(`{"capacity":%d,"nodes":[[]]}`, CacheLineSize)
+
+	if got, err := (&List[int]{}).MarshalJSONLayout(); err != nil || string(got) != want {
+		t.Errorf("List.MarshalJSONLayout() = %s, %v, want %s", got, err, want)
+	}
+}
+
+func TestList_MarshalJSONLayout(t *testing.T) {
+	got, err := NewListCustomCap[string](nodeSize).MarshalJSONLayout()
+
+	if err != nil {
+		t.Fatalf("List.MarshalJSONLayout() error = %v", err)
+	}
+
+	if want := `{"capacity":4,"nodes":[[]]}`; string(got) != want {
+		t.Errorf("List.MarshalJSONLayout() = %s, want %s", got, want)
+	}
+}
+
+func TestList_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		ul        *List[int]
+		data      string
+		want      []int
+		wantSizes []int
+		wantErr   bool
+	}{
+		{
+			"unmarshalJSONTest",
+			NewListCustomCap[int](nodeSize),
+			`[0, 1, 2, 3, 4, 5, 6, 7, 8]`,
+			[]int{0, 1, 2, 3, 4, 5, 6, 7, 8},
+			[]int{4, 4, 1},
+			false,
+		},
+
+		{
+			"unmarshalJSONZeroListTest",
+			&List[int]{},
+			`[1, 2]`,
+			[]int{1, 2},
+			[]int{2},
+			false,
+		},
+
+		{
+			"unmarshalJSONLayoutTest",
+			&List[int]{},
+			`{"capacity": 4, "nodes": [[0, 1], [2, 3], [4, 5, 6]]}`,
+			[]int{0, 1, 2, 3, 4, 5, 6},
+			[]int{2, 2, 3},
+			false,
+		},
+
+		{
+			"unmarshalJSONNullTest",
+			newRangeList(nodeSize, 0, 2),
+			`null`,
+			[]int{0, 1},
+			[]int{2},
+			false,
+		},
+
+		{
+			"unmarshalJSONWrongTypeTest",
+			newRangeList(nodeSize, 0, 2),
+			`["a"]`,
+			[]int{0, 1},
+			[]int{2},
+			true,
+		},
+
+		{
+			"unmarshalJSONWrongLayoutTest",
+			newRangeList(nodeSize, 0, 2),
+			`{"capacity": 1, "nodes": [[0, 1]]}`,
+			[]int{0, 1},
+			[]int{2},
+			true,
+		},
+
+		{
+			"unmarshalJSONHugeCapacityTest",
+			newRangeList(nodeSize, 0, 2),
+			`{"capacity": 100000000000000, "nodes": [[1]]}`,
+			[]int{0, 1},
+			[]int{2},
+			true,
+		},
+
+		{
+			"unmarshalJSONEmptyNodeTest",
+			newRangeList(nodeSize, 0, 2),
+			`{"capacity": 4, "nodes": [[], [1, 2]]}`,
+			[]int{0, 1},
+			[]int{2},
+			true,
+		},
+
+		{
+			"unmarshalJSONUnderfilledNodeTest",
+			newRangeList(nodeSize, 0, 2),
+			`{"capacity": 4, "nodes": [[1], [2, 3]]}`,
+			[]int{0, 1},
+			[]int{2},
+			true,
+		},
+		{
+			"unmarshalJSONPolicyTest",
+			newRangeList(nodeSize, 0, 2),
+			`{"capacity": 4, "nodes": [[1], [2, 3]], "policy": {"minFill": 1, "split": 2}}`,
+			[]int{1, 2, 3},
+			[]int{1, 2},
+			false,
+		},
+
+		{
+			"unmarshalJSONWrongPolicyTest",
+			newRangeList(nodeSize, 0, 2),
+			`{"capacity": 4, "nodes": [[1, 2]], "policy": {"minFill": 3, "split": 2}}`,
+			[]int{0, 1},
+			[]int{2},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ul.UnmarshalJSON([]byte(tt.data))
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("List.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got := tt.ul.ExportElems(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("List.UnmarshalJSON() = %v, want %v", got, tt.want)
+			}
+
+			if got := nodeSizes(tt.ul); !reflect.DeepEqual(got, tt.wantSizes) {
+				t.Errorf("List.UnmarshalJSON() node sizes = %v, want %v", got, tt.wantSizes)
+			}
+
+			if err := tt.ul.Validate(); err != nil {
+				t.Errorf("List.UnmarshalJSON() broke list's structure: %v", err)
+			}
+		})
+	}
+}
+
+func TestList_JSONRoundTrip(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	type doc struct {
+		Points *List[point] `json:"points"`
+		Layout *List[point] `json:"layout"`
+	}
+
+	src := doc{
+		NewListCustomCap[point](nodeSize),
+		NewListCustomCap[point](nodeSize, WithJSONLayout()),
+	}
+
+	for i := 0; i < 10; i++ {
+		src.Points.Push(point{i, -i})
+		src.Layout.InsertAt(i/2, point{i, i})
+	}
+
+	data, err := json.Marshal(src)
+
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var got doc
+
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(got.Points.ExportElems(), src.Points.ExportElems()) {
+		t.Errorf("Decoded elements = %v, want %v", got.Points.ExportElems(), src.Points.ExportElems())
+	}
+
+	if err := got.Points.Validate(); err != nil {
+		t.Errorf("json.Unmarshal() broke list's structure: %v", err)
+	}
+
+	checkSameLayout(t, got.Layout, src.Layout)
+
+	again, _ := json.Marshal(got)
+
+	if string(again) != string(data) {
+		t.Errorf("Encoded again = %s, want %s", again, data)
+	}
+}
+
+func TestList_JSONPolicyRoundTrip(t *testing.T) {
+	src := NewListCustomCap[int](8, WithJSONLayout(), WithMinFill(1), WithAppendOptimizedSplit())
+
+	src.PushAll(intRange(0, 20))
+
+	// leave the first node filled less than a half
+	for i := 0; i < 5; i++ {
+		src.RemoveFromNode(0, 0)
+	}
+
+	data, err := json.Marshal(src)
+
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	got := &List[int]{}
+
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+	}
+
+	checkSameLayout(t, got, src)
+
+	if got.first.policy() != src.first.policy() || !got.first.opts.jsonLayout {
+		t.Errorf("json.Unmarshal() policy = %+v, want %+v", got.first.policy(), src.first.policy())
+	}
+
+	if err := got.Validate(); err != nil {
+		t.Errorf("json.Unmarshal() broke list's structure: %v", err)
+	}
+}
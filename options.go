@@ -0,0 +1,232 @@
+package goulist
+
+// options represents split and merge policy of list's nodes.
+// It is shared by all nodes of the list, except nodes linked from another
+// list by Concat or Splice, which keep options of the same policy
+// (see samePolicy).
+type options struct {
+	capacity    int     // max number of node's elements
+	nodeBytes   int     // size of node's elements array in bytes, overrides capacity
+	splitRatio  float64 // part of elements kept in the full node when it is split
+	minFill     int     // min number of node's elements, except the last node
+	appendSplit bool    // leave full last node behind on appending
+	jsonLayout  bool    // encode list to JSON with its node layout
+	split       int     // number of elements kept in the full node when it is split
+	equal       any     // func(a, b T) bool comparing elements, nil for ==
+}
+
+// policy is a comparable summary of the split and merge policy of nodes.
+// Nodes with different options, which have the same policy, behave the same.
+type policy struct {
+	minFill     int
+	split       int
+	appendSplit bool
+}
+
+// policy returns summary of node's policy, so policies of nodes can be
+// compared by value.
+func (un *ulistNode[T]) policy() policy {
+	p := policy{
+		minFill: un.minFill(),
+		split:   un.splitPoint(),
+	}
+
+	if un.opts != nil {
+		p.appendSplit = un.opts.appendSplit
+	}
+
+	return p
+}
+
+// samePolicy checks if the node and node other split, merge and compare
+// elements the same way. Equality functions (see WithEqual) can not be
+// compared, so nodes with one of them have the same policy only if they
+// share options. Encoding flags, like WithJSONLayout, are not compared.
+func (un *ulistNode[T]) samePolicy(other *ulistNode[T]) bool {
+	if un.opts != other.opts && (un.hasEqual() || other.hasEqual()) {
+		return false
+	}
+
+	return un.policy() == other.policy()
+}
+
+// hasEqual checks if node's elements are compared by equality function
+// instead of == operator.
+func (un *ulistNode[T]) hasEqual() bool {
+	return un.opts != nil && un.opts.equal != nil
+}
+
+// Option configures list created by NewUlist, NewList and other constructors.
+type Option func(*options)
+
+// WithCapacity sets max number of elements of each list's node, but no
+// more than MaxNodeCapacity. It overrides capacity given to the constructor.
+func WithCapacity(c int) Option {
+	return func(o *options) {
+		o.capacity = c
+		o.nodeBytes = 0
+	}
+}
+
+// WithNodeBytes sets max number of elements of each list's node, so that
+// node's elements array takes no more than b bytes, e.g. one or a few
+// cache lines (see CacheLineSize) or a page (see PageSize). Node keeps at least
+// one element and no more than MaxNodeCapacity elements. It overrides
+// capacity given to the constructor.
+func WithNodeBytes(b int) Option {
+	return func(o *options) {
+		o.nodeBytes = b
+	}
+}
+
+// WithSplitRatio sets part of elements, from 0 to 1, kept in the full node
+// when it is split by insertion. Default ratio is 0.5, so elements are split
+// evenly. Both nodes keep at least min fill elements after split, so unless
+// min fill is set by WithMinFill, it is lowered to the number of elements
+// of the smaller node after split. Min fill set by WithMinFill is kept,
+// and the ratio is limited by it.
+func WithSplitRatio(r float64) Option {
+	return func(o *options) {
+		o.splitRatio = r
+	}
+}
+
+// WithMinFill sets min number of elements of each list's node, except
+// the last one. Elements are redistributed between nodes after deletion
+// if node has less elements. It is half of node's capacity by default,
+// greater values are reduced to it.
+func WithMinFill(n int) Option {
+	return func(o *options) {
+		o.minFill = n
+	}
+}
+
+// WithAppendOptimizedSplit makes appending to the full last node create
+// a new node for the appended element only, so the full node is left behind
+// instead of being split in halves. Insertions into the middle of the list
+// still split nodes according to split ratio (see WithSplitRatio).
+func WithAppendOptimizedSplit() Option {
+	return func(o *options) {
+		o.appendSplit = true
+	}
+}
+
+// WithJSONLayout makes MarshalJSON encode the list as a document with node
+// capacity and elements of each node instead of a plain array of elements.
+// It is useful for debugging and exact round-tripping of node layout.
+func WithJSONLayout() Option {
+	return func(o *options) {
+		o.jsonLayout = true
+	}
+}
+
+// WithEqual sets function used to compare list's elements instead of ==
+// operator by IsContains, IsContainsAll, RemoveAllOccurrences and
+// RemoveAllOfSlice. It allows to match elements by key, e.g. structs by ID,
+// and to store elements which are not comparable, like slices and maps,
+// without panics. Element type of the list must be T, constructor panics
+// otherwise. The function is not encoded by MarshalBinary and MarshalJSON,
+// but decoding into the list keeps it.
+func WithEqual[T any](eq func(a, b T) bool) Option {
+	return func(o *options) {
+		o.equal = nil
+
+		if eq != nil {
+			o.equal = eq
+		}
+	}
+}
+
+// newOptions creates policy of nodes with capacity c and elements of size
+// elemSize bytes configured by given options. It returns nil if no options
+// are given, so default policy is used.
+func newOptions(c int, elemSize uintptr, opts []Option) *options {
+	if len(opts) == 0 {
+		return nil
+	}
+
+	o := &options{
+		capacity:   c,
+		splitRatio: -1,
+		minFill:    -1,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.nodeBytes > 0 {
+		o.capacity = capacityFor(o.nodeBytes, elemSize)
+	}
+
+	if o.capacity < 1 {
+		o.capacity = 1
+	}
+
+	if o.capacity > MaxNodeCapacity {
+		o.capacity = MaxNodeCapacity
+	}
+
+	// explicit split ratio lowers implied min fill, so that it takes effect
+	if o.splitRatio >= 0 && o.minFill < 0 {
+		split := int(float64(o.capacity)*o.splitRatio + 0.5)
+		split = max(1, min(split, o.capacity-1))
+
+		o.minFill = min(split, o.capacity-split)
+	}
+
+	if o.splitRatio < 0 {
+		o.splitRatio = 0.5
+	}
+
+	if o.minFill < 0 || o.minFill > o.capacity/2 {
+		o.minFill = o.capacity / 2
+	}
+
+	// both nodes keep at least min fill elements, and at least one element
+	// is moved to the new node
+	var (
+		low  = o.minFill
+		high = o.capacity - o.minFill
+	)
+
+	if low < 1 {
+		low = 1
+	}
+
+	if high > o.capacity-1 {
+		high = o.capacity - 1
+	}
+
+	if high < low {
+		high = low
+	}
+
+	o.split = int(float64(o.capacity)*o.splitRatio + 0.5)
+
+	if o.split < low {
+		o.split = low
+	}
+
+	if o.split > high {
+		o.split = high
+	}
+
+	return o
+}
+
+// capacityFor returns number of elements of size elemSize bytes which fit
+// into b bytes, but not less than one.
+func capacityFor(b int, elemSize uintptr) int {
+	if elemSize == 0 {
+		elemSize = 1
+	}
+
+	c := b / int(elemSize)
+
+	if c < 1 {
+		c = 1
+	}
+
+	return c
+}
@@ -0,0 +1,272 @@
+package goulist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_newOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		c           int
+		opts        []Option
+		wantNil     bool
+		wantCap     int
+		wantMinFill int
+		wantSplit   int
+	}{
+		{"noOptionsTest", 8, nil, true, 0, 0, 0},
+		{"capacityTest", 8, []Option{WithCapacity(10)}, false, 10, 5, 5},
+		{"oddCapacityTest", 8, []Option{WithCapacity(5)}, false, 5, 2, 3},
+		{"zeroCapacityTest", 8, []Option{WithCapacity(0)}, false, 1, 0, 1},
+		{"minFillTest", 8, []Option{WithMinFill(2)}, false, 8, 2, 4},
+		{"minFillTooHighTest", 8, []Option{WithMinFill(7)}, false, 8, 4, 4},
+		{
+			"splitRatioTest",
+			8,
+			[]Option{WithMinFill(1), WithSplitRatio(0.75)},
+			false,
+			8,
+			1,
+			6,
+		},
+		{
+			"splitRatioLimitedTest",
+			8,
+			[]Option{WithMinFill(2), WithSplitRatio(1)},
+			false,
+			8,
+			2,
+			6,
+		},
+		{"splitRatioMinFillTest", 8, []Option{WithSplitRatio(0.75)}, false, 8, 2, 6},
+		{"splitRatioHighTest", 8, []Option{WithSplitRatio(0.9)}, false, 8, 1, 7},
+		{"splitRatioLowTest", 8, []Option{WithSplitRatio(0)}, false, 8, 1, 1},
+		{"splitRatioEvenTest", 8, []Option{WithSplitRatio(0.5)}, false, 8, 4, 4},
+		{"splitRatioSmallCapacityTest", 1, []Option{WithSplitRatio(0.9)}, false, 1, 0, 1},
+		{"nodeBytesTest", 8, []Option{WithNodeBytes(64)}, false, 8, 4, 4},
+		{"nodeBytesSmallTest", 8, []Option{WithNodeBytes(4)}, false, 1, 0, 1},
+		{
+			"nodeBytesOverriddenTest",
+			8,
+			[]Option{WithNodeBytes(256), WithCapacity(6)},
+			false,
+			6,
+			3,
+			3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newOptions(tt.c, 8, tt.opts)
+
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("newOptions() = %v, wantNil %v", got, tt.wantNil)
+			}
+
+			if got == nil {
+				return
+			}
+
+			if got.capacity != tt.wantCap || got.minFill != tt.wantMinFill ||
+				got.split != tt.wantSplit {
+				t.Errorf(
+					"newOptions() = {capacity %d, minFill %d, split %d}, want {%d, %d, %d}",
+					got.capacity, got.minFill, got.split,
+					tt.wantCap, tt.wantMinFill, tt.wantSplit,
+				)
+			}
+		})
+	}
+}
+
+func TestWithCapacity(t *testing.T) {
+	ul := NewList[int](WithCapacity(6))
+
+	if len(ul.first.elems) != 6 || ul.first.capacity != 6 {
+		t.Errorf("List node length = %d, but %d needed", len(ul.first.elems), 6)
+	}
+
+	for i := 0; i < 20; i++ {
+		ul.Push(i)
+	}
+
+	for node := ul.first; node != nil; node = node.next {
+		if node.capacity != 6 || node.opts != ul.first.opts {
+			t.Errorf("New nodes must inherit list's options")
+		}
+	}
+}
+
+func TestWithAppendOptimizedSplit(t *testing.T) {
+	ul := NewListCustomCap[int](nodeSize, WithAppendOptimizedSplit())
+
+	for i := 0; i < 10; i++ {
+		ul.Push(i)
+	}
+
+	if got := nodeSizes(ul); !reflect.DeepEqual(got, []int{4, 4, 2}) {
+		t.Errorf("Push() node sizes = %v, want full nodes left behind", got)
+	}
+
+	// insertion into the middle still splits node evenly
+	ul.InsertAt(1, 99)
+
+	if got := nodeSizes(ul); !reflect.DeepEqual(got, []int{3, 2, 4, 2}) {
+		t.Errorf("InsertAt() node sizes = %v, want %v", got, []int{3, 2, 4, 2})
+	}
+
+	want := []int{0, 99, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List elements = %v, want %v", got, want)
+	}
+}
+
+func TestWithSplitRatio(t *testing.T) {
+	// min fill is not set, split ratio lowers it
+	ul := NewListCustomCap[int](8, WithSplitRatio(0.75))
+
+	for i := 0; i < 8; i++ {
+		ul.Push(i)
+	}
+
+	ul.InsertAt(0, 99)
+
+	if got := nodeSizes(ul); !reflect.DeepEqual(got, []int{7, 2}) {
+		t.Errorf("InsertAt() node sizes = %v, want %v", got, []int{7, 2})
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("InsertAt() broke list's structure: %v", err)
+	}
+}
+
+func TestWithMinFill(t *testing.T) {
+	ul := NewListCustomCap[int](8, WithMinFill(2), WithSplitRatio(0.75))
+
+	for i := 0; i < 8; i++ {
+		ul.Push(i)
+	}
+
+	ul.InsertAt(0, 99)
+
+	if got := nodeSizes(ul); !reflect.DeepEqual(got, []int{7, 2}) {
+		t.Errorf("InsertAt() node sizes = %v, want %v", got, []int{7, 2})
+	}
+
+	// first node may be reduced to 2 elements without redistribution
+	for i := 0; i < 5; i++ {
+		ul.RemoveAt(0)
+	}
+
+	if got := nodeSizes(ul); !reflect.DeepEqual(got, []int{2, 2}) {
+		t.Errorf("RemoveAt() node sizes = %v, want %v", got, []int{2, 2})
+	}
+
+	ul.RemoveAt(0)
+
+	if got := nodeSizes(ul); !reflect.DeepEqual(got, []int{3}) {
+		t.Errorf("RemoveAt() node sizes = %v, want %v", got, []int{3})
+	}
+}
+
+func Test_capacityFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		b        int
+		elemSize uintptr
+		want     int
+	}{
+		{"cacheLineTest", 64, 16, 4},
+		{"remainderTest", 100, 16, 6},
+		{"tooSmallTest", 8, 16, 1},
+		{"zeroSizeTest", 64, 0, 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := capacityFor(tt.b, tt.elemSize); got != tt.want {
+				t.Errorf("capacityFor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewListBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		got  int
+		want int
+	}{
+		{"int64CacheLineTest", NewListBytes[int64](CacheLineSize).NodeCapacity(), CacheLineSize / 8},
+		{"int32CacheLinesTest", NewListBytes[int32](4 * CacheLineSize).NodeCapacity(), CacheLineSize},
+		{"bytePageTest", NewListBytes[byte](PageSize).NodeCapacity(), PageSize},
+		{
+			"ulistCacheLineTest",
+			NewUlist(WithNodeBytes(CacheLineSize)).NodeCapacity(),
+			CacheLineSize / 16,
+		},
+		{"emptyStructTest", NewListBytes[struct{}](CacheLineSize).NodeCapacity(), CacheLineSize},
+		{"byteHugeTest", NewListBytes[byte](MaxNodeCapacity * 4).NodeCapacity(), MaxNodeCapacity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("NodeCapacity() = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_NodeCapacity(t *testing.T) {
+	if got := NewUlist().NodeCapacity(); got != CacheLineSize {
+		t.Errorf("NodeCapacity() = %v, want %v", got, CacheLineSize)
+	}
+
+	if got := NewListCustomCap[int](nodeSize).NodeCapacity(); got != nodeSize {
+		t.Errorf("NodeCapacity() = %v, want %v", got, nodeSize)
+	}
+}
+
+func TestMaxNodeCapacity(t *testing.T) {
+	tests := []struct {
+		name string
+		ul   *List[byte]
+	}{
+		{"customCapTest", NewListCustomCap[byte](MaxNodeCapacity * 2)},
+		{"withCapacityTest", NewList[byte](WithCapacity(MaxNodeCapacity + 1))},
+		{"withJSONLayoutTest", NewListCustomCap[byte](MaxNodeCapacity*2, WithJSONLayout())},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ul.NodeCapacity(); got != MaxNodeCapacity {
+				t.Fatalf("NodeCapacity() = %v, want %v", got, MaxNodeCapacity)
+			}
+
+			tt.ul.PushAll(make([]byte, MaxNodeCapacity+1))
+
+			// any list is accepted by decoder
+			data, err := tt.ul.MarshalBinary()
+
+			if err != nil {
+				t.Fatalf("List.MarshalBinary() error = %v", err)
+			}
+
+			if err := (&List[byte]{}).UnmarshalBinary(data); err != nil {
+				t.Errorf("List.UnmarshalBinary() error = %v", err)
+			}
+
+			if data, err = tt.ul.MarshalJSONLayout(); err != nil {
+				t.Fatalf("List.MarshalJSONLayout() error = %v", err)
+			}
+
+			if err := (&List[byte]{}).UnmarshalJSON(data); err != nil {
+				t.Errorf("List.UnmarshalJSON() error = %v", err)
+			}
+		})
+	}
+}
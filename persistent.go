@@ -0,0 +1,462 @@
+package goulist
+
+import (
+	"errors"
+	"iter"
+)
+
+// PersistentList is an immutable unrolled linked list. Its editing methods
+// return a new version of the list, leaving the current one unchanged.
+// Versions share all of the nodes which are not touched by the edit: the
+// edit copies only one or two nodes and O(log n) entries of balanced tree,
+// which links list's nodes in order instead of prev and next links. So each
+// edit costs O(node capacity) and historical versions are cheap to keep.
+//
+// Nodes are split and merged by the same rules as nodes of List. Zero
+// PersistentList is not valid, use one of constructors.
+// PersistentList is safe for concurrent reading, since it is never changed.
+type PersistentList[T any] struct {
+	root  *pnode[T]     // nil for empty list
+	proto *ulistNode[T] // empty node with capacity and policy of the list
+}
+
+// PersistentUlist is a PersistentList of interface{} elements.
+type PersistentUlist = PersistentList[interface{}]
+
+// pnode is a node of balanced (AVL) tree of list's nodes. Leaf holds list's
+// node, inner node holds two subtrees. pnode is never changed after creation,
+// so PersistentList, which never changes list's nodes either, shares them
+// between versions. SortedList uses the tree as an index of its nodes and
+// replaces leaves of the nodes it changes.
+type pnode[T any] struct {
+	left   *pnode[T]
+	right  *pnode[T]
+	leaf   *ulistNode[T] // nil for inner node
+	last   *ulistNode[T] // the last list's node of the subtree
+	height int
+	nodes  int // number of list's nodes in the subtree
+	length int // number of elements in the subtree
+}
+
+// newLeaf creates leaf holding list's node un.
+func newLeaf[T any](un *ulistNode[T]) *pnode[T] {
+	return &pnode[T]{
+		leaf:   un,
+		last:   un,
+		height: 1,
+		nodes:  1,
+		length: un.size,
+	}
+}
+
+// join creates inner node with subtrees l and r without balancing.
+func join[T any](l, r *pnode[T]) *pnode[T] {
+	return &pnode[T]{
+		left:   l,
+		right:  r,
+		last:   r.last,
+		height: max(l.height, r.height) + 1,
+		nodes:  l.nodes + r.nodes,
+		length: l.length + r.length,
+	}
+}
+
+// balance joins subtrees l and r, which heights differ by at most 2,
+// rotating them if needed to keep the tree balanced.
+func balance[T any](l, r *pnode[T]) *pnode[T] {
+	switch {
+	case l.height > r.height+1:
+		if l.left.height >= l.right.height {
+			return join(l.left, join(l.right, r))
+		}
+
+		return join(join(l.left, l.right.left), join(l.right.right, r))
+	case r.height > l.height+1:
+		if r.right.height >= r.left.height {
+			return join(join(l, r.left), r.right)
+		}
+
+		return join(join(l, r.left.left), join(r.left.right, r.right))
+	}
+
+	return join(l, r)
+}
+
+// buildTree creates balanced tree holding given list's nodes in order.
+func buildTree[T any](leaves []*ulistNode[T]) *pnode[T] {
+	switch len(leaves) {
+	case 0:
+		return nil
+	case 1:
+		return newLeaf(leaves[0])
+	}
+
+	mid := len(leaves) / 2
+
+	return join(buildTree(leaves[:mid]), buildTree(leaves[mid:]))
+}
+
+// locate finds list's node containing element with the given index.
+// Returns number of the node, the node and index of element in it.
+// index must be in range of elements of the tree.
+func (p *pnode[T]) locate(index int) (int, *ulistNode[T], int) {
+	k := 0
+
+	for p.leaf == nil {
+		if index < p.left.length {
+			p = p.left
+		} else {
+			index -= p.left.length
+			k += p.left.nodes
+			p = p.right
+		}
+	}
+
+	return k, p.leaf, index
+}
+
+// leafAt returns list's node number k.
+func (p *pnode[T]) leafAt(k int) *ulistNode[T] {
+	for p.leaf == nil {
+		if k < p.left.nodes {
+			p = p.left
+		} else {
+			k -= p.left.nodes
+			p = p.right
+		}
+	}
+
+	return p.leaf
+}
+
+// set returns new tree, where list's node number k is replaced with un.
+func (p *pnode[T]) set(k int, un *ulistNode[T]) *pnode[T] {
+	if p.leaf != nil {
+		return newLeaf(un)
+	}
+
+	if k < p.left.nodes {
+		return join(p.left.set(k, un), p.right)
+	}
+
+	return join(p.left, p.right.set(k-p.left.nodes, un))
+}
+
+// insert returns new tree, where list's node un is inserted before node
+// number k, or after the last node if k is equal to number of nodes.
+func (p *pnode[T]) insert(k int, un *ulistNode[T]) *pnode[T] {
+	if p == nil {
+		return newLeaf(un)
+	}
+
+	if p.leaf != nil {
+		if k == 0 {
+			return join(newLeaf(un), p)
+		}
+
+		return join(p, newLeaf(un))
+	}
+
+	if k < p.left.nodes {
+		return balance(p.left.insert(k, un), p.right)
+	}
+
+	return balance(p.left, p.right.insert(k-p.left.nodes, un))
+}
+
+// delete returns new tree without list's node number k.
+// Returns nil if the tree becomes empty.
+func (p *pnode[T]) delete(k int) *pnode[T] {
+	if p.leaf != nil {
+		return nil
+	}
+
+	if k < p.left.nodes {
+		l := p.left.delete(k)
+
+		if l == nil {
+			return p.right
+		}
+
+		return balance(l, p.right)
+	}
+
+	r := p.right.delete(k - p.left.nodes)
+
+	if r == nil {
+		return p.left
+	}
+
+	return balance(p.left, r)
+}
+
+// do calls fn on each list's node in order, until fn returns false.
+// It returns false if walk was stopped.
+func (p *pnode[T]) do(fn func(*ulistNode[T]) bool) bool {
+	if p == nil {
+		return true
+	}
+
+	if p.leaf != nil {
+		return fn(p.leaf)
+	}
+
+	return p.left.do(fn) && p.right.do(fn)
+}
+
+// newPersistentList creates new empty persistent list with node capacity c,
+// configured by given options.
+func newPersistentList[T any](c int, opts ...Option) *PersistentList[T] {
+	return &PersistentList[T]{
+		proto: newUlist[T](c, opts...).first,
+	}
+}
+
+// NewPersistentUlist creates new empty PersistentUlist with node capacity
+// CacheLineSize. Options are applied as for NewUlist.
+func NewPersistentUlist(opts ...Option) *PersistentUlist {
+	return newPersistentList[interface{}](CacheLineSize, opts...)
+}
+
+// NewPersistentUlistCustomCap creates new empty PersistentUlist with node
+// capacity c. Options are applied as for NewUlistCustomCap.
+func NewPersistentUlistCustomCap(c int, opts ...Option) *PersistentUlist {
+	return newPersistentList[interface{}](c, opts...)
+}
+
+// NewPersistentList creates new empty PersistentList of elements of type T
+// with node capacity CacheLineSize. Options are applied as for NewList.
+func NewPersistentList[T any](opts ...Option) *PersistentList[T] {
+	return newPersistentList[T](CacheLineSize, opts...)
+}
+
+// NewPersistentListCustomCap creates new empty PersistentList of elements
+// of type T with node capacity c. Options are applied as for NewListCustomCap.
+func NewPersistentListCustomCap[T any](c int, opts ...Option) *PersistentList[T] {
+	return newPersistentList[T](c, opts...)
+}
+
+// Persistent returns persistent list with copy of list's nodes, so it is not
+// changed by following changes of the list.
+func (ul *List[T]) Persistent() *PersistentList[T] {
+	leaves := make([]*ulistNode[T], 0, ul.size)
+
+	for node := ul.first; node != nil; node = node.next {
+		if node.size > 0 {
+			leaves = append(leaves, node.clone())
+		}
+	}
+
+	return &PersistentList[T]{
+		root:  buildTree(leaves),
+		proto: ul.first.newNode(),
+	}
+}
+
+// List returns mutable list with copy of nodes of the persistent list.
+func (pl *PersistentList[T]) List() *List[T] {
+	nodes := make([][]T, 0, pl.GetSize())
+
+	pl.root.do(func(un *ulistNode[T]) bool {
+		nodes = append(nodes, un.elems[:un.size])
+		return true
+	})
+
+	if len(nodes) == 0 {
+		nodes = append(nodes, nil)
+	}
+
+	ul := &List[T]{}
+	ul.restore(pl.proto.capacity, nodes, pl.proto.opts)
+
+	return ul
+}
+
+// with returns new version of the list with given tree.
+func (pl *PersistentList[T]) with(root *pnode[T]) *PersistentList[T] {
+	return &PersistentList[T]{
+		root:  root,
+		proto: pl.proto,
+	}
+}
+
+// NodeCapacity returns max number of elements of each list's node.
+func (pl *PersistentList[T]) NodeCapacity() int {
+	return pl.proto.capacity
+}
+
+// GetSize returns number of list's nodes. Empty persistent list has no nodes.
+func (pl *PersistentList[T]) GetSize() int {
+	if pl.root == nil {
+		return 0
+	}
+
+	return pl.root.nodes
+}
+
+// Len returns number of list's elements.
+func (pl *PersistentList[T]) Len() int {
+	if pl.root == nil {
+		return 0
+	}
+
+	return pl.root.length
+}
+
+// At returns element with the given logical index and error if index
+// is out of range.
+func (pl *PersistentList[T]) At(index int) (T, error) {
+	var zero T
+
+	if index < 0 || index > pl.Len()-1 {
+		return zero, errors.New("Element index is out of range")
+	}
+
+	_, node, i := pl.root.locate(index)
+
+	return node.elems[i], nil
+}
+
+// Push returns new version of the list with element val appended to the end.
+// Only the last node is copied.
+func (pl *PersistentList[T]) Push(val T) *PersistentList[T] {
+	if pl.root == nil {
+		node := pl.proto.newNode()
+		node.add(val)
+
+		return pl.with(newLeaf(node))
+	}
+
+	var (
+		k       = pl.root.nodes - 1
+		node    = pl.root.leafAt(k).clone()
+		newNode = node.add(val)
+		root    = pl.root.set(k, node)
+	)
+
+	if newNode != nil {
+		root = root.insert(k+1, newNode)
+	}
+
+	return pl.with(root)
+}
+
+// Set returns new version of the list, where element with the given logical
+// index is replaced with given element val, and error if index is out
+// of range. Only the node containing the element is copied.
+func (pl *PersistentList[T]) Set(index int, val T) (*PersistentList[T], error) {
+	if index < 0 || index > pl.Len()-1 {
+		return pl, errors.New("Element index is out of range")
+	}
+
+	k, node, i := pl.root.locate(index)
+
+	node = node.clone()
+	node.elems[i] = val
+
+	return pl.with(pl.root.set(k, node)), nil
+}
+
+// Insert returns new version of the list, where new element val is inserted
+// before element with the given logical index, and error if index is out of
+// range. If index is equal to list's length, val is appended to the end of
+// list. Only the node containing the element is copied, it is split if full.
+func (pl *PersistentList[T]) Insert(index int, val T) (*PersistentList[T], error) {
+	if index == pl.Len() {
+		return pl.Push(val), nil
+	}
+
+	if index < 0 || index > pl.Len() {
+		return pl, errors.New("Element index is out of range")
+	}
+
+	k, node, i := pl.root.locate(index)
+
+	node = node.clone()
+	newNode := node.insert(i, val)
+	root := pl.root.set(k, node)
+
+	if newNode != nil {
+		root = root.insert(k+1, newNode)
+	}
+
+	return pl.with(root), nil
+}
+
+// Remove returns new version of the list without element with the given
+// logical index, and error if index is out of range. Elements are
+// redistributed between the node containing the element and the next
+// node as List does it, only these nodes are copied.
+func (pl *PersistentList[T]) Remove(index int) (*PersistentList[T], error) {
+	if index < 0 || index > pl.Len()-1 {
+		return pl, errors.New("Element index is out of range")
+	}
+
+	k, node, i := pl.root.locate(index)
+
+	node = node.clone()
+	node.del(i)
+
+	root := pl.root
+
+	// link copy of the next node for redistribution
+	if node.size < node.minFill() && k+1 < root.nodes {
+		next := root.leafAt(k + 1).clone()
+		node.next = next
+
+		if node.redistribAfterDeletion() > 0 {
+			root = root.delete(k + 1)
+		} else {
+			root = root.set(k+1, next)
+			node.next = nil
+		}
+	}
+
+	if node.size == 0 {
+		return pl.with(root.delete(k)), nil
+	}
+
+	return pl.with(root.set(k, node)), nil
+}
+
+// ExportElems returns slice filled with all list's elements.
+func (pl *PersistentList[T]) ExportElems() []T {
+	target := make([]T, 0, pl.Len())
+
+	pl.root.do(func(un *ulistNode[T]) bool {
+		target = append(target, un.elems[:un.size]...)
+		return true
+	})
+
+	return target
+}
+
+// All returns an iterator over indexes and elements of the list.
+func (pl *PersistentList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+
+		pl.root.do(func(un *ulistNode[T]) bool {
+			for _, v := range un.elems[:un.size] {
+				if !yield(i, v) {
+					return false
+				}
+
+				i++
+			}
+
+			return true
+		})
+	}
+}
+
+// Values returns an iterator over elements of the list.
+func (pl *PersistentList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range pl.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package goulist
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// checkTree checks balance and counters of the tree. Returns its height.
+func checkTree[T any](t *testing.T, p *pnode[T]) int {
+	t.Helper()
+
+	if p.leaf != nil {
+		if p.leaf.size == 0 || p.length != p.leaf.size || p.nodes != 1 || p.height != 1 {
+			t.Fatalf("Broken leaf of size %d", p.leaf.size)
+		}
+
+		if p.leaf.next != nil || p.leaf.prev != nil {
+			t.Fatalf("Leaf is linked to other nodes")
+		}
+
+		return 1
+	}
+
+	l, r := checkTree(t, p.left), checkTree(t, p.right)
+
+	if l-r > 1 || r-l > 1 || p.height != max(l, r)+1 {
+		t.Fatalf("Unbalanced tree, heights are %d and %d", l, r)
+	}
+
+	if p.nodes != p.left.nodes+p.right.nodes || p.length != p.left.length+p.right.length {
+		t.Fatalf("Wrong counters of inner node")
+	}
+
+	return p.height
+}
+
+func TestPersistentList(t *testing.T) {
+	var (
+		pl       = NewPersistentListCustomCap[int](nodeSize)
+		want     = []int{}
+		versions = []*PersistentList[int]{pl}
+		models   = [][]int{want}
+		rnd      = rand.New(rand.NewSource(1))
+	)
+
+	for i := 0; i < 2000; i++ {
+		var err error
+
+		switch op := rnd.Intn(10); {
+		case op < 3:
+			pl = pl.Push(i)
+			want = append(want, i)
+		case op < 6:
+			index := rnd.Intn(len(want) + 1)
+			pl, err = pl.Insert(index, i)
+			want = append(want[:index:index], append([]int{i}, want[index:]...)...)
+		case op < 7 && len(want) > 0:
+			index := rnd.Intn(len(want))
+			pl, err = pl.Set(index, -i)
+			want = append([]int{}, want...)
+			want[index] = -i
+		case len(want) > 0:
+			index := rnd.Intn(len(want))
+			pl, err = pl.Remove(index)
+			want = append(want[:index:index], want[index+1:]...)
+		}
+
+		if err != nil {
+			t.Fatalf("PersistentList edit error = %v", err)
+		}
+
+		versions = append(versions, pl)
+		models = append(models, want)
+	}
+
+	// every version is kept unchanged
+	for i, v := range versions {
+		if v.root != nil {
+			checkTree(t, v.root)
+		}
+
+		if got := v.ExportElems(); !reflect.DeepEqual(got, models[i]) || v.Len() != len(models[i]) {
+			t.Fatalf("Version %d = %v, want %v", i, got, models[i])
+		}
+
+		if err := v.List().Validate(); err != nil {
+			t.Fatalf("PersistentList.List() of version %d is broken: %v", i, err)
+		}
+	}
+
+	for i, v := range want {
+		if got, err := pl.At(i); err != nil || got != v {
+			t.Fatalf("PersistentList.At(%d) = %v, %v, want %v", i, got, err, v)
+		}
+	}
+}
+
+func TestPersistentList_Sharing(t *testing.T) {
+	var pl = NewPersistentListCustomCap[int](nodeSize)
+
+	for i := 0; i < 100; i++ {
+		pl = pl.Push(i)
+	}
+
+	next, _ := pl.Set(50, -1)
+
+	var (
+		old     = []*ulistNode[int]{}
+		changed = 0
+	)
+
+	pl.root.do(func(un *ulistNode[int]) bool {
+		old = append(old, un)
+		return true
+	})
+
+	next.root.do(func(un *ulistNode[int]) bool {
+		if un != old[0] {
+			changed++
+		}
+
+		old = old[1:]
+
+		return true
+	})
+
+	if changed != 1 {
+		t.Errorf("PersistentList.Set() copied %d nodes, want 1", changed)
+	}
+
+	if v, _ := pl.At(50); v != 50 {
+		t.Errorf("PersistentList.Set() changed previous version")
+	}
+}
+
+func TestPersistentList_Errors(t *testing.T) {
+	pl := NewPersistentListCustomCap[int](nodeSize).Push(1)
+
+	if _, err := pl.At(1); err == nil {
+		t.Errorf("PersistentList.At() error = nil for index out of range")
+	}
+
+	if _, err := pl.Set(-1, 0); err == nil {
+		t.Errorf("PersistentList.Set() error = nil for index out of range")
+	}
+
+	if _, err := pl.Insert(2, 0); err == nil {
+		t.Errorf("PersistentList.Insert() error = nil for index out of range")
+	}
+
+	if _, err := pl.Remove(1); err == nil {
+		t.Errorf("PersistentList.Remove() error = nil for index out of range")
+	}
+
+	if empty, _ := pl.Remove(0); empty.Len() != 0 || empty.GetSize() != 0 {
+		t.Errorf("PersistentList is not empty after removing of all elements")
+	}
+}
+
+func TestList_Persistent(t *testing.T) {
+	var (
+		ul   = newRangeList(nodeSize, 0, 10)
+		pl   = ul.Persistent()
+		want = ul.ExportElems()
+	)
+
+	ul.Push(10)
+	ul.SetAt(0, -1)
+
+	if got := pl.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List.Persistent() = %v, want %v", got, want)
+	}
+
+	got := []int{}
+
+	for i, v := range pl.All() {
+		if i != len(got) {
+			t.Errorf("PersistentList.All() index = %d, want %d", i, len(got))
+		}
+
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PersistentList.All() = %v, want %v", got, want)
+	}
+
+	back := pl.Push(10).List()
+	back.Push(11)
+
+	if got, want := back.ExportElems(), append(want, 10, 11); !reflect.DeepEqual(got, want) {
+		t.Errorf("PersistentList.List() = %v, want %v", got, want)
+	}
+
+	if back.NodeCapacity() != nodeSize || pl.Len() != 10 {
+		t.Errorf("PersistentList.List() changed persistent list")
+	}
+
+	if err := back.Validate(); err != nil {
+		t.Errorf("PersistentList.List() is broken: %v", err)
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("List.Push() broke list's structure: %v", err)
+	}
+
+	if empty := NewPersistentUlist().List(); empty.Len() != 0 || empty.GetSize() != 1 || empty.Validate() != nil {
+		t.Errorf("PersistentList.List() of empty list has %d nodes", empty.GetSize())
+	}
+}
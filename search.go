@@ -0,0 +1,201 @@
+package goulist
+
+// ContainsFunc returns true if list contains at least one element for which
+// pred returns true. It stops at the first such element.
+func (ul *List[T]) ContainsFunc(pred func(T) bool) bool {
+	return ul.IndexFunc(pred) >= 0
+}
+
+// IndexFunc returns logical index of the first element for which pred
+// returns true, or -1 if there is no such element. It stops at the first
+// such element.
+func (ul *List[T]) IndexFunc(pred func(T) bool) int {
+	index := -1
+
+	ul.find(pred, func(i, _, _ int) bool {
+		index = i
+		return false
+	})
+
+	return index
+}
+
+// IndexOf returns logical index of the first occurrence of element val,
+// or -1 if list does not contain it. It stops at the first occurrence.
+func (ul *List[T]) IndexOf(val T) int {
+	return ul.IndexFunc(func(v T) bool {
+		return ul.equals(val, v)
+	})
+}
+
+// LastIndexOf returns logical index of the last occurrence of element val,
+// or -1 if list does not contain it. It walks the list backwards from the
+// last node and stops at the last occurrence.
+func (ul *List[T]) LastIndexOf(val T) int {
+	index := ul.length
+
+	for node := ul.last; node != nil; node = node.prev {
+		index -= node.size
+
+		for i := node.size - 1; i >= 0; i-- {
+			if ul.equals(val, node.elems[i]) {
+				return index + i
+			}
+		}
+	}
+
+	return -1
+}
+
+// Location is a position of list's element given by index of the node
+// and index of the element in that node, as Get, Set and RemoveFromNode
+// take it.
+type Location struct {
+	NodeNum int
+	ElemNum int
+}
+
+// FindAll returns logical indexes of all elements for which pred returns
+// true, in ascending order.
+func (ul *List[T]) FindAll(pred func(T) bool) []int {
+	indexes := []int{}
+
+	ul.find(pred, func(i, _, _ int) bool {
+		indexes = append(indexes, i)
+		return true
+	})
+
+	return indexes
+}
+
+// FindAllLocations returns locations of all elements for which pred returns
+// true, in list's order.
+func (ul *List[T]) FindAllLocations(pred func(T) bool) []Location {
+	locs := []Location{}
+
+	ul.find(pred, func(_, nodeNum, elemNum int) bool {
+		locs = append(locs, Location{nodeNum, elemNum})
+		return true
+	})
+
+	return locs
+}
+
+// find calls fn with logical index, node's index and element's index of each
+// element for which pred returns true, until fn returns false.
+func (ul *List[T]) find(pred func(T) bool, fn func(index, nodeNum, elemNum int) bool) {
+	var (
+		index   = 0
+		nodeNum = 0
+	)
+
+	for node := ul.first; node != nil; node = node.next {
+		for i := 0; i < node.size; i++ {
+			if pred(node.elems[i]) && !fn(index+i, nodeNum, i) {
+				return
+			}
+		}
+
+		index += node.size
+		nodeNum++
+	}
+}
+
+// RemoveFunc removes all elements for which pred returns true from the list.
+// Elements are removed from all nodes first, then they are redistributed
+// between nodes, as RemoveAllOccurrences does it. Unlike DeleteFunc, it
+// keeps elements in their nodes where possible. Returns the number
+// of removed elements.
+func (ul *List[T]) RemoveFunc(pred func(T) bool) int {
+	ul.unshare()
+
+	n := 0
+
+	for node := ul.first; node != nil; node = node.next {
+		n += node.compactFunc(pred)
+	}
+
+	ul.length -= n
+	ul.rebalance()
+
+	return n
+}
+
+// DeleteFunc removes all elements for which del returns true from the list
+// in a single walk over it. Remaining elements are packed into full nodes
+// on the way (see RetainFunc). Returns the number of removed elements.
+func (ul *List[T]) DeleteFunc(del func(T) bool) int {
+	return ul.RetainFunc(func(v T) bool {
+		return !del(v)
+	})
+}
+
+// RetainFunc keeps only elements for which keep returns true and removes
+// the rest of them from the list in a single walk over it. Kept elements
+// are moved towards the start of the list on the way, so all nodes except
+// the last one become full, and the nodes left without elements are cut
+// off from the end of the list. Returns the number of removed elements.
+func (ul *List[T]) RetainFunc(keep func(T) bool) int {
+	ul.unshare()
+
+	var (
+		zero T
+		w    = ul.first // node kept elements are moved to
+		wi   = 0        // index of the next kept element in w
+		size = 1
+	)
+
+	for node := ul.first; node != nil; node = node.next {
+		for i := 0; i < node.size; i++ {
+			if !keep(node.elems[i]) {
+				continue
+			}
+
+			// w is always behind the node, or w is the node and wi <= i,
+			// so elements are never overwritten before they are checked
+			if wi == w.capacity {
+				w.size = wi
+				w = w.next
+				wi = 0
+				size++
+			}
+
+			w.elems[wi] = node.elems[i]
+			wi++
+		}
+	}
+
+	n := ul.length - (size-1)*w.capacity - wi
+
+	for i := wi; i < w.capacity; i++ {
+		w.elems[i] = zero
+	}
+
+	w.size = wi
+	w.next = nil
+
+	ul.last = w
+	ul.size = size
+	ul.length -= n
+
+	return n
+}
+
+// RemoveAllOfSliceFunc removes all elements of the list, which are equal
+// to any element of given slice vals by given function eq, in a single walk
+// over the list. eq is called with element of vals and element of the list.
+func (ul *List[T]) RemoveAllOfSliceFunc(vals []T, eq func(a, b T) bool) {
+	if len(vals) == 0 {
+		return
+	}
+
+	ul.RemoveFunc(func(v T) bool {
+		for i := range vals {
+			if eq(vals[i], v) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
@@ -0,0 +1,367 @@
+package goulist
+
+import (
+	"reflect"
+	"testing"
+)
+
+type searchTestItem struct {
+	ID   int
+	Tags []string
+}
+
+func sameID(a, b searchTestItem) bool {
+	return a.ID == b.ID
+}
+
+func newSearchTestList(opts ...Option) *List[searchTestItem] {
+	ul := NewListCustomCap[searchTestItem](nodeSize, opts...)
+
+	for i := 0; i < 10; i++ {
+		ul.Push(searchTestItem{i % 5, []string{"tag"}})
+	}
+
+	return ul
+}
+
+func searchTestIDs(ul *List[searchTestItem]) []int {
+	ids := []int{}
+
+	for _, v := range ul.ExportElems() {
+		ids = append(ids, v.ID)
+	}
+
+	return ids
+}
+
+func TestList_IndexFunc(t *testing.T) {
+	tests := []struct {
+		name  string
+		id    int
+		want  int
+		calls int
+	}{
+		{"indexFuncFirstTest", 0, 0, 1},
+		{"indexFuncNextNodeTest", 3, 3, 4},
+		{"indexFuncNotFoundTest", 7, -1, 10},
+	}
+
+	ul := newSearchTestList()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+
+			pred := func(v searchTestItem) bool {
+				calls++
+				return v.ID == tt.id
+			}
+
+			if got := ul.IndexFunc(pred); got != tt.want || calls != tt.calls {
+				t.Errorf("List.IndexFunc() = %v after %d calls, want %v after %d calls",
+					got, calls, tt.want, tt.calls)
+			}
+
+			if got := ul.ContainsFunc(pred); got != (tt.want >= 0) {
+				t.Errorf("List.ContainsFunc() = %v, want %v", got, tt.want >= 0)
+			}
+		})
+	}
+}
+
+func TestList_RemoveFunc(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 20)
+
+	if got := ul.RemoveFunc(func(v int) bool { return v%3 != 0 }); got != 13 {
+		t.Errorf("List.RemoveFunc() = %v, want %v", got, 13)
+	}
+
+	if got, want := ul.ExportElems(), []int{0, 3, 6, 9, 12, 15, 18}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List.RemoveFunc() left %v, want %v", got, want)
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("List.RemoveFunc() broke list's structure: %v", err)
+	}
+
+	if got := ul.RemoveFunc(func(v int) bool { return true }); got != 7 || ul.Len() != 0 || ul.GetSize() != 1 {
+		t.Errorf("List.RemoveFunc() removed %d elements, left %d nodes", got, ul.GetSize())
+	}
+}
+
+func TestList_RemoveAllOfSliceFunc(t *testing.T) {
+	ul := newSearchTestList()
+
+	ul.RemoveAllOfSliceFunc([]searchTestItem{{ID: 1}, {ID: 3}}, sameID)
+
+	if got, want := searchTestIDs(ul), []int{0, 2, 4, 0, 2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List.RemoveAllOfSliceFunc() left %v, want %v", got, want)
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("List.RemoveAllOfSliceFunc() broke list's structure: %v", err)
+	}
+}
+
+func TestWithEqual(t *testing.T) {
+	ul := newSearchTestList(WithEqual(sameID))
+
+	// elements are not comparable, == would panic
+	if !ul.IsContains(searchTestItem{ID: 4}) || ul.IsContains(searchTestItem{ID: 5}) {
+		t.Errorf("List.IsContains() does not use WithEqual function")
+	}
+
+	if !ul.IsContainsAll([]searchTestItem{{ID: 0}, {ID: 2}}) {
+		t.Errorf("List.IsContainsAll() does not use WithEqual function")
+	}
+
+	ul.RemoveAllOccurrences(searchTestItem{ID: 2})
+	ul.RemoveAllOfSlice([]searchTestItem{{ID: 0}, {ID: 4}})
+
+	if got, want := searchTestIDs(ul), []int{1, 3, 1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List.RemoveAll...() left %v, want %v", got, want)
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("List.RemoveAll...() broke list's structure: %v", err)
+	}
+
+	cl := NewConcurrentListCustomCap[searchTestItem](nodeSize, WithEqual(sameID))
+	cl.Push(searchTestItem{ID: 1})
+
+	if !cl.IsContains(searchTestItem{ID: 1}) {
+		t.Errorf("ConcurrentList.IsContains() does not use WithEqual function")
+	}
+}
+
+func TestWithEqualTypeMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewList() did not panic on WithEqual of another type")
+		}
+	}()
+
+	NewList[int](WithEqual(func(a, b string) bool { return a == b }))
+}
+
+func TestList_IndexOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		val      int
+		want     int
+		wantLast int
+	}{
+		{"indexOfFirstTest", 0, 0, 5},
+		{"indexOfLastTest", 4, 4, 9},
+		{"indexOfMissingTest", 5, -1, -1},
+	}
+
+	calls := 0
+
+	ul := NewListCustomCap[int](nodeSize, WithEqual(func(a, b int) bool {
+		calls++
+		return a == b
+	}))
+
+	ul.PushAll([]int{0, 1, 2, 3, 4, 0, 1, 2, 3, 4})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ul.IndexOf(tt.val); got != tt.want {
+				t.Errorf("List.IndexOf() = %v, want %v", got, tt.want)
+			}
+
+			if got := ul.LastIndexOf(tt.val); got != tt.wantLast {
+				t.Errorf("List.LastIndexOf() = %v, want %v", got, tt.wantLast)
+			}
+		})
+	}
+
+	calls = 0
+
+	if ul.LastIndexOf(3) != 8 || calls != 2 {
+		t.Errorf("List.LastIndexOf() compared %d elements, want %d", calls, 2)
+	}
+}
+
+func TestList_equals(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10, WithEqual(func(a, b int) bool { return a%5 == b%5 }))
+
+	// a node with its own options must not change the way the list compares
+	opts := *ul.last.opts
+	opts.equal = func(a, b int) bool { return a == b }
+	ul.last.opts = &opts
+
+	if got := ul.IndexOf(7); got != 2 {
+		t.Errorf("List.IndexOf() = %v, want %v", got, 2)
+	}
+
+	if got := ul.LastIndexOf(2); got != 7 {
+		t.Errorf("List.LastIndexOf() = %v, want %v", got, 7)
+	}
+
+	if !ul.IsContains(12) {
+		t.Errorf("List.IsContains() does not use equality of the list")
+	}
+
+	ul.RemoveAllOccurrences(3)
+
+	if got, want := ul.ExportElems(), []int{0, 1, 2, 4, 5, 6, 7, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List.RemoveAllOccurrences() = %v, want %v", got, want)
+	}
+}
+
+func TestList_FindAll(t *testing.T) {
+	// nodes are {0, 1} {2, 3} {4, 5} {6, 7, 8, 9}
+	ul := newRangeList(nodeSize, 0, 10)
+	odd := func(v int) bool { return v%2 == 1 }
+
+	if got, want := ul.FindAll(odd), []int{1, 3, 5, 7, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("List.FindAll() = %v, want %v", got, want)
+	}
+
+	locs := ul.FindAllLocations(odd)
+	want := []Location{{0, 1}, {1, 1}, {2, 1}, {3, 1}, {3, 3}}
+
+	if !reflect.DeepEqual(locs, want) {
+		t.Errorf("List.FindAllLocations() = %v, want %v", locs, want)
+	}
+
+	for _, loc := range locs {
+		if v, err := ul.Get(loc.NodeNum, loc.ElemNum); err != nil || !odd(v) {
+			t.Errorf("List.Get() = %v, %v at location %v", v, err, loc)
+		}
+	}
+
+	if got := ul.FindAll(func(v int) bool { return v > 9 }); len(got) != 0 {
+		t.Errorf("List.FindAll() = %v, want no indexes", got)
+	}
+}
+
+func TestList_RetainFunc(t *testing.T) {
+	tests := []struct {
+		name      string
+		keep      func(int) bool
+		want      []int
+		wantSizes []int
+	}{
+		{"retainFuncSomeTest", func(v int) bool { return v%3 != 1 }, []int{0, 2, 3, 5, 6, 8, 9, 11}, []int{4, 4}},
+		{"retainFuncAllTest", func(v int) bool { return true }, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, []int{4, 4, 4}},
+		{"retainFuncOneTest", func(v int) bool { return v == 11 }, []int{11}, []int{1}},
+		{"retainFuncNoneTest", func(v int) bool { return false }, []int{}, []int{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ul := newRangeList(nodeSize, 0, 12)
+			calls := 0
+
+			n := ul.RetainFunc(func(v int) bool {
+				calls++
+				return tt.keep(v)
+			})
+
+			if got := ul.ExportElems(); !reflect.DeepEqual(got, tt.want) || n != 12-len(tt.want) {
+				t.Errorf("List.RetainFunc() = %v, %d, want %v, %d", got, n, tt.want, 12-len(tt.want))
+			}
+
+			if calls != 12 {
+				t.Errorf("List.RetainFunc() called keep %d times, want %d", calls, 12)
+			}
+
+			if got := nodeSizes(ul); !reflect.DeepEqual(got, tt.wantSizes) {
+				t.Errorf("List.RetainFunc() node sizes = %v, want %v", got, tt.wantSizes)
+			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("List.RetainFunc() broke list's structure: %v", err)
+			}
+		})
+	}
+}
+
+func TestList_DeleteFunc(t *testing.T) {
+	opts := [][]Option{
+		nil,
+		{WithMinFill(1)},
+		{WithAppendOptimizedSplit()},
+	}
+
+	for _, o := range opts {
+		ul := NewListCustomCap[int](nodeSize, o...)
+		model := []int{}
+
+		// fragment nodes with insertions into the middle
+		for i := 0; i < 200; i++ {
+			index := (i * 7) % (len(model) + 1)
+
+			ul.InsertAt(index, i)
+			model = append(model[:index], append([]int{i}, model[index:]...)...)
+		}
+
+		del := func(v int) bool { return v%4 == 0 || v%5 == 0 }
+
+		want := []int{}
+
+		for _, v := range model {
+			if !del(v) {
+				want = append(want, v)
+			}
+		}
+
+		n := ul.DeleteFunc(del)
+
+		if got := ul.ExportElems(); !reflect.DeepEqual(got, want) || n != 200-len(want) {
+			t.Errorf("List.DeleteFunc() = %v, %d, want %v, %d", got, n, want, 200-len(want))
+		}
+
+		if err := ul.Validate(); err != nil {
+			t.Errorf("List.DeleteFunc() broke list's structure: %v", err)
+		}
+
+		for node := ul.first; node != ul.last; node = node.next {
+			if !node.isFull() {
+				t.Fatalf("List.DeleteFunc() left node with %d elements", node.size)
+			}
+		}
+	}
+}
+
+func TestWithEqualDecoding(t *testing.T) {
+	var (
+		src    = newSearchTestList()
+		layout = newSearchTestList(WithJSONLayout())
+	)
+
+	bin, _ := src.MarshalBinary()
+	binPolicy, _ := newSearchTestList(WithMinFill(1)).MarshalBinary()
+	jsonLayout, _ := layout.MarshalJSON()
+
+	tests := []struct {
+		name   string
+		decode func(ul *List[searchTestItem]) error
+	}{
+		{"equalBinaryTest", func(ul *List[searchTestItem]) error { return ul.UnmarshalBinary(bin) }},
+		{"equalBinaryPolicyTest", func(ul *List[searchTestItem]) error { return ul.UnmarshalBinary(binPolicy) }},
+		{"equalJSONLayoutTest", func(ul *List[searchTestItem]) error { return ul.UnmarshalJSON(jsonLayout) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ul := NewListCustomCap[searchTestItem](nodeSize, WithEqual(sameID))
+
+			if err := tt.decode(ul); err != nil {
+				t.Fatalf("decoding error = %v", err)
+			}
+
+			// elements are not comparable, == would panic
+			if !ul.IsContains(searchTestItem{ID: 4}) || ul.Len() != 10 {
+				t.Errorf("Decoded list does not use WithEqual function")
+			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("Decoded list is broken: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package goulist
+
+import (
+	"io"
+	"iter"
+)
+
+// Clone returns deep copy of the list. Nodes of the copy have the same
+// capacity, policy and elements as list's nodes, so layout of the list
+// is kept and no elements are moved between nodes.
+func (ul *List[T]) Clone() *List[T] {
+	var (
+		cl   = &List[T]{size: ul.size, length: ul.length}
+		prev *ulistNode[T]
+	)
+
+	for node := ul.first; node != nil; node = node.next {
+		c := node.clone()
+
+		if prev == nil {
+			cl.first = c
+		} else {
+			prev.next = c
+			c.prev = prev
+		}
+
+		prev = c
+	}
+
+	cl.last = prev
+
+	return cl
+}
+
+// unshare copies list's nodes if they are shared with a snapshot. It is called
+// before any change of the list. Returns true if nodes were copied.
+func (ul *List[T]) unshare() bool {
+	if !ul.shared {
+		return false
+	}
+
+	cl := ul.Clone()
+
+	ul.first = cl.first
+	ul.last = cl.last
+	ul.shared = false
+
+	return true
+}
+
+// Snapshot is a read-only view of the list at some moment.
+// See List.Snapshot for details.
+type Snapshot[T any] struct {
+	list *List[T]
+}
+
+// Snapshot returns read-only view of the list at the moment of the call.
+// It takes O(1) time: snapshot shares nodes with the list until the list
+// is changed, then the list copies its nodes once (see Clone) and following
+// changes do not copy them until the next snapshot, including changes
+// made through slices yielded by List.Chunks. Elements passed by
+// Snapshot.Chunks must not be changed, since they are shared with the list.
+func (ul *List[T]) Snapshot() *Snapshot[T] {
+	ul.shared = true
+
+	return &Snapshot[T]{
+		list: &List[T]{
+			first:  ul.first,
+			last:   ul.last,
+			size:   ul.size,
+			length: ul.length,
+			shared: true,
+		},
+	}
+}
+
+// Clone returns mutable deep copy of the snapshot.
+func (s *Snapshot[T]) Clone() *List[T] {
+	return s.list.Clone()
+}
+
+// NodeCapacity returns max number of elements of each snapshot's node.
+func (s *Snapshot[T]) NodeCapacity() int {
+	return s.list.NodeCapacity()
+}
+
+// GetSize returns number of snapshot's nodes.
+func (s *Snapshot[T]) GetSize() int {
+	return s.list.GetSize()
+}
+
+// GetFirst returns slice filled with all snapshot's first node elements.
+func (s *Snapshot[T]) GetFirst() []T {
+	return s.list.GetFirst()
+}
+
+// GetLast returns slice filled with all snapshot's last node elements.
+func (s *Snapshot[T]) GetLast() []T {
+	return s.list.GetLast()
+}
+
+// Len returns number of snapshot's elements.
+func (s *Snapshot[T]) Len() int {
+	return s.list.Len()
+}
+
+// Get returns element with index elemNum from node with index nodeNum.
+func (s *Snapshot[T]) Get(nodeNum, elemNum int) (T, error) {
+	return s.list.Get(nodeNum, elemNum)
+}
+
+// At returns element with the given logical index and error if index
+// is out of range.
+func (s *Snapshot[T]) At(index int) (T, error) {
+	return s.list.At(index)
+}
+
+// PeekFront returns the first snapshot's element.
+// It returns false if snapshot is empty.
+func (s *Snapshot[T]) PeekFront() (T, bool) {
+	return s.list.PeekFront()
+}
+
+// PeekBack returns the last snapshot's element.
+// It returns false if snapshot is empty.
+func (s *Snapshot[T]) PeekBack() (T, bool) {
+	return s.list.PeekBack()
+}
+
+// ExportElems returns slice filled with all snapshot's elements.
+func (s *Snapshot[T]) ExportElems() []T {
+	return s.list.ExportElems()
+}
+
+// IsContains returns true if snapshot contains at least one element val.
+func (s *Snapshot[T]) IsContains(val T) bool {
+	return s.list.IsContains(val)
+}
+
+// IsContainsAll returns true if snapshot contains all of the elements
+// of the given slice.
+func (s *Snapshot[T]) IsContainsAll(vals []T) bool {
+	return s.list.IsContainsAll(vals)
+}
+
+// Print prints each snapshot's element.
+func (s *Snapshot[T]) Print() {
+	s.list.Print()
+}
+
+// Printc (Print custom) prints each snapshot's element to given io.Writer w.
+func (s *Snapshot[T]) Printc(w io.Writer) {
+	s.list.Printc(w)
+}
+
+// All returns an iterator over indexes and elements of the snapshot.
+func (s *Snapshot[T]) All() iter.Seq2[int, T] {
+	return s.list.All()
+}
+
+// Backward returns an iterator over indexes and elements of the snapshot,
+// from the last element to the first one.
+func (s *Snapshot[T]) Backward() iter.Seq2[int, T] {
+	return s.list.Backward()
+}
+
+// Values returns an iterator over elements of the snapshot.
+func (s *Snapshot[T]) Values() iter.Seq[T] {
+	return s.list.Values()
+}
+
+// Chunks returns an iterator over elements of snapshot's nodes. Slices
+// share storage with nodes of the list, so they are read-only and
+// elements must not be changed through them.
+func (s *Snapshot[T]) Chunks() iter.Seq[[]T] {
+	return s.list.chunks()
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s *Snapshot[T]) MarshalBinary() ([]byte, error) {
+	return s.list.MarshalBinary()
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *Snapshot[T]) MarshalJSON() ([]byte, error) {
+	return s.list.MarshalJSON()
+}
+
+// IsSortedFunc returns true if snapshot's elements are sorted as determined
+// by function cmp.
+func (s *Snapshot[T]) IsSortedFunc(cmp func(a, b T) int) bool {
+	return s.list.IsSortedFunc(cmp)
+}
+
+// ContainsFunc returns true if snapshot contains at least one element
+// for which pred returns true.
+func (s *Snapshot[T]) ContainsFunc(pred func(T) bool) bool {
+	return s.list.ContainsFunc(pred)
+}
+
+// IndexFunc returns logical index of the first snapshot's element for which
+// pred returns true, or -1 if there is no such element.
+func (s *Snapshot[T]) IndexFunc(pred func(T) bool) int {
+	return s.list.IndexFunc(pred)
+}
+
+// IndexOf returns logical index of the first occurrence of element val
+// in snapshot, or -1 if snapshot does not contain it.
+func (s *Snapshot[T]) IndexOf(val T) int {
+	return s.list.IndexOf(val)
+}
+
+// LastIndexOf returns logical index of the last occurrence of element val
+// in snapshot, or -1 if snapshot does not contain it.
+func (s *Snapshot[T]) LastIndexOf(val T) int {
+	return s.list.LastIndexOf(val)
+}
+
+// FindAll returns logical indexes of all snapshot's elements for which
+// pred returns true.
+func (s *Snapshot[T]) FindAll(pred func(T) bool) []int {
+	return s.list.FindAll(pred)
+}
+
+// FindAllLocations returns locations of all snapshot's elements for which
+// pred returns true.
+func (s *Snapshot[T]) FindAllLocations(pred func(T) bool) []Location {
+	return s.list.FindAllLocations(pred)
+}
@@ -0,0 +1,142 @@
+package goulist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestList_Clone(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+	ul.InsertAt(3, 99)
+
+	cl := ul.Clone()
+
+	checkSameLayout(t, cl, ul)
+
+	for node, c := ul.first, cl.first; node != nil; node, c = node.next, c.next {
+		if node == c || &node.elems[0] == &c.elems[0] {
+			t.Fatalf("List.Clone() shares nodes with the list")
+		}
+	}
+
+	cl.SetAt(0, -1)
+
+	if v, _ := ul.At(0); v != 0 {
+		t.Errorf("Change of the clone changed the list")
+	}
+
+	if err := cl.Validate(); err != nil {
+		t.Errorf("List.SetAt() broke clone's structure: %v", err)
+	}
+}
+
+func TestList_Snapshot(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(ul *List[int])
+	}{
+		{"snapshotPushTest", func(ul *List[int]) { ul.Push(-1) }},
+		{"snapshotInsertTest", func(ul *List[int]) { ul.Insert(-1, 0) }},
+		{"snapshotSetTest", func(ul *List[int]) { ul.Set(0, 0, -1) }},
+		{"snapshotSetAtTest", func(ul *List[int]) { ul.SetAt(5, -1) }},
+		{"snapshotInsertAtTest", func(ul *List[int]) { ul.InsertAt(5, -1) }},
+		{"snapshotInsertAllTest", func(ul *List[int]) { ul.InsertAll(5, []int{-1, -2}) }},
+		{"snapshotRemoveAtTest", func(ul *List[int]) { ul.RemoveAt(5) }},
+		{"snapshotRemoveFromNodeTest", func(ul *List[int]) { ul.RemoveFromNode(1, 0) }},
+		{"snapshotRemoveAllOccurrencesTest", func(ul *List[int]) { ul.RemoveAllOccurrences(4) }},
+		{"snapshotRemoveFuncTest", func(ul *List[int]) { ul.RemoveFunc(func(v int) bool { return v > 4 }) }},
+		{"snapshotRetainFuncTest", func(ul *List[int]) { ul.RetainFunc(func(v int) bool { return v%2 == 0 }) }},
+		{"snapshotPushFrontTest", func(ul *List[int]) { ul.PushFront(-1) }},
+		{"snapshotPopFrontTest", func(ul *List[int]) { ul.PopFront() }},
+		{"snapshotPopBackTest", func(ul *List[int]) { ul.PopBack() }},
+		{"snapshotDoTest", func(ul *List[int]) { ul.Do(func(p *int) { *p = -1 }) }},
+		{"snapshotClearTest", func(ul *List[int]) { ul.Clear() }},
+		{"snapshotChunksTest", func(ul *List[int]) {
+			for c := range ul.Chunks() {
+				c[0] = -1
+			}
+		}},
+		{"snapshotIteratorSetTest", func(ul *List[int]) {
+			it := ul.Iterator()
+			it.Next()
+			it.Set(-1)
+		}},
+		{"snapshotIteratorRemoveTest", func(ul *List[int]) {
+			it := ul.Iterator()
+			it.Seek(5)
+			it.Remove()
+			it.InsertBefore(-1)
+			it.InsertAfter(-2)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ul   = newRangeList(nodeSize, 0, 10)
+				want = ul.ExportElems()
+				snap = ul.Snapshot()
+			)
+
+			if snap.list.first != ul.first {
+				t.Fatalf("List.Snapshot() does not share nodes with the list")
+			}
+
+			tt.change(ul)
+
+			if got := snap.ExportElems(); !reflect.DeepEqual(got, want) || snap.Len() != len(want) {
+				t.Errorf("Snapshot = %v, want %v", got, want)
+			}
+
+			if got := ul.ExportElems(); reflect.DeepEqual(got, want) {
+				t.Errorf("List is not changed after snapshot")
+			}
+
+			if err := ul.Validate(); err != nil {
+				t.Errorf("Change of the list broke its structure: %v", err)
+			}
+
+			if err := snap.list.Validate(); err != nil {
+				t.Errorf("Change of the list broke snapshot's structure: %v", err)
+			}
+		})
+	}
+}
+
+func TestList_SnapshotCopiesOnce(t *testing.T) {
+	ul := newRangeList(nodeSize, 0, 10)
+
+	var (
+		snap  = ul.Snapshot()
+		first = ul.first
+	)
+
+	// reading does not copy nodes
+	ul.ExportElems()
+	ul.IsContains(5)
+
+	if ul.first != first {
+		t.Fatalf("Reading of the list copied its nodes")
+	}
+
+	ul.SetAt(0, -1)
+	first = ul.first
+
+	if first == snap.list.first {
+		t.Fatalf("Change of the list did not copy its nodes")
+	}
+
+	ul.SetAt(1, -1)
+
+	if ul.first != first {
+		t.Errorf("The second change copied nodes again")
+	}
+
+	if v, _ := snap.At(0); v != 0 || snap.Clone().Len() != 10 {
+		t.Errorf("Snapshot is changed")
+	}
+
+	if err := ul.Validate(); err != nil {
+		t.Errorf("List.SetAt() broke list's structure: %v", err)
+	}
+}
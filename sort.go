@@ -0,0 +1,129 @@
+package goulist
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+)
+
+// run is a sorted node, which elements are merged with other nodes.
+type run[T any] struct {
+	elems []T
+	node  int // index of the node, to merge equal elements stably
+}
+
+// runHeap is a min-heap of sorted nodes ordered by their first elements.
+type runHeap[T any] struct {
+	runs []run[T]
+	cmp  func(a, b T) int
+}
+
+func (h *runHeap[T]) Len() int { return len(h.runs) }
+
+func (h *runHeap[T]) Less(i, j int) bool {
+	if c := h.cmp(h.runs[i].elems[0], h.runs[j].elems[0]); c != 0 {
+		return c < 0
+	}
+
+	return h.runs[i].node < h.runs[j].node
+}
+
+func (h *runHeap[T]) Swap(i, j int) { h.runs[i], h.runs[j] = h.runs[j], h.runs[i] }
+
+func (h *runHeap[T]) Push(x any) { h.runs = append(h.runs, x.(run[T])) }
+
+func (h *runHeap[T]) Pop() any {
+	r := h.runs[len(h.runs)-1]
+	h.runs = h.runs[:len(h.runs)-1]
+
+	return r
+}
+
+// sort sorts elements of each node with function sortNode, then merges
+// sorted nodes and writes merged elements back to the nodes in order.
+// Layout of nodes is not changed, so their fill stays the same.
+func (ul *List[T]) sort(cmp func(a, b T) int, sortNode func([]T, func(a, b T) int)) {
+	ul.unshare()
+
+	h := &runHeap[T]{cmp: cmp}
+
+	for node, i := ul.first, 0; node != nil; node, i = node.next, i+1 {
+		if node.size > 0 {
+			sortNode(node.elems[:node.size], cmp)
+			h.runs = append(h.runs, run[T]{node.elems[:node.size], i})
+		}
+	}
+
+	if len(h.runs) < 2 {
+		return
+	}
+
+	heap.Init(h)
+
+	merged := make([]T, 0, ul.length)
+
+	for h.Len() > 0 {
+		r := &h.runs[0]
+		merged = append(merged, r.elems[0])
+
+		if r.elems = r.elems[1:]; len(r.elems) == 0 {
+			heap.Pop(h)
+		} else {
+			heap.Fix(h, 0)
+		}
+	}
+
+	for node := ul.first; node != nil; node = node.next {
+		merged = merged[copy(node.elems[:node.size], merged):]
+	}
+}
+
+// SortFunc sorts list's elements in ascending order as determined by function
+// cmp, which returns negative number if a < b, positive number if a > b and
+// zero if a == b. Elements of each node are sorted in place, then nodes are
+// merged. Nodes keep their number of elements. The sort is not guaranteed
+// to be stable.
+func (ul *List[T]) SortFunc(cmp func(a, b T) int) {
+	ul.sort(cmp, slices.SortFunc[[]T])
+}
+
+// SortStableFunc sorts list's elements as SortFunc does, keeping the original
+// order of equal elements.
+func (ul *List[T]) SortStableFunc(cmp func(a, b T) int) {
+	ul.sort(cmp, slices.SortStableFunc[[]T])
+}
+
+// IsSortedFunc returns true if list's elements are sorted in ascending order
+// as determined by function cmp (see SortFunc). It stops at the first
+// element out of order.
+func (ul *List[T]) IsSortedFunc(cmp func(a, b T) int) bool {
+	var (
+		prev  T
+		first = true
+	)
+
+	for node := ul.first; node != nil; node = node.next {
+		for _, v := range node.elems[:node.size] {
+			if !first && cmp(v, prev) < 0 {
+				return false
+			}
+
+			prev = v
+			first = false
+		}
+	}
+
+	return true
+}
+
+// Sort sorts elements of list ul of ordered type in ascending order.
+// See List.SortFunc.
+func Sort[T cmp.Ordered](ul *List[T]) {
+	ul.SortFunc(cmp.Compare[T])
+}
+
+// IsSorted returns true if elements of list ul of ordered type are sorted
+// in ascending order.
+func IsSorted[T cmp.Ordered](ul *List[T]) bool {
+	return ul.IsSortedFunc(cmp.Compare[T])
+}
@@ -0,0 +1,131 @@
+package goulist
+
+import (
+	"cmp"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestList_SortFunc(t *testing.T) {
+	tests := []struct {
+		name string
+		vals []int
+	}{
+		{"sortEmptyTest", []int{}},
+		{"sortOneNodeTest", []int{3, 1, 2}},
+		{"sortSortedTest", []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"sortReversedTest", []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}},
+		{"sortDuplicatesTest", []int{2, 1, 2, 1, 0, 2, 1, 0, 0, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ul := NewListCustomCap[int](nodeSize)
+
+			for _, v := range tt.vals {
+				ul.Push(v)
+			}
+
+			sizes := nodeSizes(ul)
+			want := slices.Clone(tt.vals)
+			slices.Sort(want)
+
+			Sort(ul)
+
+			if got := ul.ExportElems(); !reflect.DeepEqual(got, want) {
+				t.Errorf("Sort() = %v, want %v", got, want)
+			}
+
+			if got := nodeSizes(ul); !reflect.DeepEqual(got, sizes) {
+				t.Errorf("Sort() changed node sizes to %v, want %v", got, sizes)
+			}
+
+			if err := ul.Validate(); err != nil || !IsSorted(ul) {
+				t.Errorf("Sort() broke the list: %v", err)
+			}
+		})
+	}
+}
+
+func TestList_SortRandom(t *testing.T) {
+	var (
+		rnd  = rand.New(rand.NewSource(1))
+		ul   = NewListCustomCap[int](7)
+		want = []int{}
+	)
+
+	for i := 0; i < 1000; i++ {
+		v := rnd.Intn(100)
+
+		ul.InsertAt(rnd.Intn(ul.Len()+1), v)
+		want = append(want, v)
+	}
+
+	ul.SortFunc(func(a, b int) int { return b - a })
+	slices.SortFunc(want, func(a, b int) int { return b - a })
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List.SortFunc() = %v, want %v", got, want)
+	}
+}
+
+func TestList_SortStableFunc(t *testing.T) {
+	type pair struct {
+		key, seq int
+	}
+
+	var (
+		ul    = NewListCustomCap[pair](nodeSize)
+		want  = []pair{}
+		byKey = func(a, b pair) int {
+			return cmp.Compare(a.key, b.key)
+		}
+	)
+
+	for i := 0; i < 50; i++ {
+		p := pair{(i * 7) % 5, i}
+
+		ul.Push(p)
+		want = append(want, p)
+	}
+
+	ul.SortStableFunc(byKey)
+	slices.SortStableFunc(want, byKey)
+
+	if got := ul.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List.SortStableFunc() = %v, want %v", got, want)
+	}
+
+	if !ul.IsSortedFunc(byKey) {
+		t.Errorf("List.IsSortedFunc() = false after sorting")
+	}
+}
+
+func TestList_IsSortedFunc(t *testing.T) {
+	tests := []struct {
+		name string
+		vals []int
+		want bool
+	}{
+		{"isSortedEmptyTest", []int{}, true},
+		{"isSortedTest", []int{1, 1, 2, 3, 5, 8, 13}, true},
+		{"isSortedAcrossNodesTest", []int{0, 1, 2, 3, 4, 3, 6}, false},
+		{"isSortedFirstTest", []int{1, 0}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ul := NewListCustomCap[int](nodeSize)
+
+			for _, v := range tt.vals {
+				ul.Push(v)
+			}
+
+			if got := IsSorted(ul); got != tt.want {
+				t.Errorf("IsSorted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package goulist
+
+import (
+	"errors"
+	"iter"
+	"sort"
+)
+
+// SortedList is an unrolled linked list which keeps its elements sorted
+// in ascending order as determined by comparator function cmp (see
+// List.SortFunc). List's nodes are indexed by balanced tree, which keeps
+// the last node and number of elements of each subtree. Searches descend
+// the tree comparing val with the last elements of subtrees to find the node,
+// then search binary inside it, so they take O(log(n/c) + log c) time for
+// list of n elements with node capacity c. Insertion and removal take
+// O(log(n/c) + c) time, they update the tree along the path to the node.
+// Equal elements are kept in order of insertion.
+type SortedList[T any] struct {
+	list  *List[T]
+	cmp   func(a, b T) int
+	index *pnode[T] // tree of list's nodes, see bound
+}
+
+// SortedUlist is a SortedList of interface{} elements.
+type SortedUlist = SortedList[interface{}]
+
+// newSortedList creates sorted list of elements of the empty list ordered
+// by cmp.
+func newSortedList[T any](list *List[T], cmp func(a, b T) int) *SortedList[T] {
+	sl := &SortedList[T]{
+		list: list,
+		cmp:  cmp,
+	}
+
+	sl.reindex()
+
+	return sl
+}
+
+// NewSortedUlist creates new empty SortedUlist ordered by cmp with node
+// capacity CacheLineSize. Options are applied as for NewUlist.
+func NewSortedUlist(cmp func(a, b interface{}) int, opts ...Option) *SortedUlist {
+	return NewSortedList(cmp, opts...)
+}
+
+// NewSortedUlistCustomCap creates new empty SortedUlist ordered by cmp with
+// node capacity c. Options are applied as for NewUlistCustomCap.
+func NewSortedUlistCustomCap(c int, cmp func(a, b interface{}) int, opts ...Option) *SortedUlist {
+	return NewSortedListCustomCap(c, cmp, opts...)
+}
+
+// NewSortedList creates new empty SortedList of elements of type T ordered
+// by cmp with node capacity CacheLineSize. Options are applied as for NewList.
+func NewSortedList[T any](cmp func(a, b T) int, opts ...Option) *SortedList[T] {
+	return newSortedList(NewList[T](opts...), cmp)
+}
+
+// NewSortedListCustomCap creates new empty SortedList of elements of type T
+// ordered by cmp with node capacity c. Options are applied as for
+// NewListCustomCap.
+func NewSortedListCustomCap[T any](c int, cmp func(a, b T) int, opts ...Option) *SortedList[T] {
+	return newSortedList(NewListCustomCap[T](c, opts...), cmp)
+}
+
+// reindex builds the tree of list's nodes. It is needed when all of the nodes
+// are replaced, e.g. copied from a snapshot or cleared.
+func (sl *SortedList[T]) reindex() {
+	leaves := make([]*ulistNode[T], 0, sl.list.size)
+
+	for node := sl.list.first; node != nil; node = node.next {
+		leaves = append(leaves, node)
+	}
+
+	sl.index = buildTree(leaves)
+}
+
+// unshare copies list's nodes shared with a snapshot and rebuilds the tree
+// of nodes after it. It is called before any change of the list.
+func (sl *SortedList[T]) unshare() {
+	if sl.list.unshare() {
+		sl.reindex()
+	}
+}
+
+// bound finds the first element which is not less than val, or greater
+// than val if upper is true. It descends the tree of nodes, going to the
+// left subtree if its last element is not less (not greater) than val, then
+// the element is searched binary inside the node. Returns number of the node,
+// the node, index of the element in it and its logical index. If there is
+// no such element, it returns the last node, its size and list's length.
+func (sl *SortedList[T]) bound(val T, upper bool) (int, *ulistNode[T], int, int) {
+	var (
+		k     = 0
+		index = 0
+		p     = sl.index
+	)
+
+	before := func(v T) bool {
+		c := sl.cmp(v, val)
+		return c < 0 || (upper && c == 0)
+	}
+
+	// nodes are not empty, unless the list has only one node
+	for p.leaf == nil {
+		if last := p.left.last; before(last.elems[last.size-1]) {
+			k += p.left.nodes
+			index += p.left.length
+			p = p.right
+		} else {
+			p = p.left
+		}
+	}
+
+	node := p.leaf
+
+	i := sort.Search(node.size, func(i int) bool {
+		return !before(node.elems[i])
+	})
+
+	return k, node, i, index + i
+}
+
+// Insert inserts element val after all elements which are not greater than it.
+// If the target node is full, it is split as List does it.
+func (sl *SortedList[T]) Insert(val T) {
+	sl.unshare()
+
+	k, node, i, _ := sl.bound(val, true)
+	newNode := node.insert(i, val)
+
+	sl.index = sl.index.set(k, node)
+
+	if newNode != nil {
+		sl.list.linkAfter(node, newNode)
+		sl.index = sl.index.insert(k+1, newNode)
+	}
+
+	sl.list.length++
+}
+
+// InsertAll inserts all of the elements of the given slice vals.
+func (sl *SortedList[T]) InsertAll(vals []T) {
+	for _, v := range vals {
+		sl.Insert(v)
+	}
+}
+
+// Remove removes the first element equal to val, as determined by cmp.
+// It returns false if there is no such element.
+func (sl *SortedList[T]) Remove(val T) bool {
+	sl.unshare()
+
+	k, node, i, _ := sl.bound(val, false)
+
+	if i == node.size || sl.cmp(node.elems[i], val) != 0 {
+		return false
+	}
+
+	sl.delete(k, node, i)
+
+	return true
+}
+
+// delete removes element with index i from the node number k and updates
+// the tree of nodes after redistribution of elements between the node and
+// its next node. Returns removed element.
+func (sl *SortedList[T]) delete(k int, node *ulistNode[T], i int) T {
+	var (
+		val  = node.elems[i]
+		next = node.next
+	)
+
+	n, _ := node.delAt(i)
+
+	sl.list.length--
+	sl.list.afterDeletion(node, n)
+
+	if n > 0 {
+		sl.index = sl.index.delete(k + 1)
+	} else if next != nil {
+		sl.index = sl.index.set(k+1, next)
+	}
+
+	// empty node is removed from the list, unless it is the only one
+	if node.size == 0 && sl.list.first != node {
+		sl.index = sl.index.delete(k)
+	} else {
+		sl.index = sl.index.set(k, node)
+	}
+
+	return val
+}
+
+// RemoveAt removes element with the given logical index. Returns removed
+// element and error if index is out of range.
+func (sl *SortedList[T]) RemoveAt(index int) (T, error) {
+	var zero T
+
+	if index < 0 || index > sl.list.length-1 {
+		return zero, errors.New("Element index is out of range")
+	}
+
+	sl.unshare()
+
+	k, node, i := sl.index.locate(index)
+
+	return sl.delete(k, node, i), nil
+}
+
+// PopFront removes the least element and returns it.
+// It returns false if list is empty.
+func (sl *SortedList[T]) PopFront() (T, bool) {
+	val, err := sl.RemoveAt(0)
+
+	return val, err == nil
+}
+
+// PopBack removes the greatest element and returns it.
+// It returns false if list is empty.
+func (sl *SortedList[T]) PopBack() (T, bool) {
+	val, err := sl.RemoveAt(sl.list.length - 1)
+
+	return val, err == nil
+}
+
+// Clear removes all list's elements.
+func (sl *SortedList[T]) Clear() {
+	sl.list.Clear()
+	sl.reindex()
+}
+
+// LowerBound returns logical index of the first element which is not less
+// than val, or list's length if there is no such element.
+func (sl *SortedList[T]) LowerBound(val T) int {
+	_, _, _, index := sl.bound(val, false)
+
+	return index
+}
+
+// UpperBound returns logical index of the first element which is greater
+// than val, or list's length if there is no such element.
+func (sl *SortedList[T]) UpperBound(val T) int {
+	_, _, _, index := sl.bound(val, true)
+
+	return index
+}
+
+// Search searches for val and returns logical index of the first element
+// equal to it and true, or index where val would be inserted and false.
+func (sl *SortedList[T]) Search(val T) (int, bool) {
+	_, node, i, index := sl.bound(val, false)
+
+	return index, i < node.size && sl.cmp(node.elems[i], val) == 0
+}
+
+// Contains returns true if list contains element equal to val.
+func (sl *SortedList[T]) Contains(val T) bool {
+	_, found := sl.Search(val)
+
+	return found
+}
+
+// Range returns an iterator over elements which are not less than lo
+// and less than hi, in ascending order.
+func (sl *SortedList[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		_, node, i, _ := sl.bound(lo, false)
+
+		for ; node != nil; node, i = node.next, 0 {
+			for ; i < node.size; i++ {
+				if sl.cmp(node.elems[i], hi) >= 0 || !yield(node.elems[i]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// At returns element with the given logical index and error if index
+// is out of range. It finds the node by the tree of nodes.
+func (sl *SortedList[T]) At(index int) (T, error) {
+	var zero T
+
+	if index < 0 || index > sl.list.length-1 {
+		return zero, errors.New("Element index is out of range")
+	}
+
+	_, node, i := sl.index.locate(index)
+
+	return node.elems[i], nil
+}
+
+// Len returns number of list's elements.
+func (sl *SortedList[T]) Len() int {
+	return sl.list.Len()
+}
+
+// ExportElems returns slice filled with all list's elements in ascending order.
+func (sl *SortedList[T]) ExportElems() []T {
+	return sl.list.ExportElems()
+}
+
+// All returns an iterator over indexes and elements in ascending order.
+func (sl *SortedList[T]) All() iter.Seq2[int, T] {
+	return sl.list.All()
+}
+
+// Values returns an iterator over elements in ascending order.
+func (sl *SortedList[T]) Values() iter.Seq[T] {
+	return sl.list.Values()
+}
+
+// Snapshot returns read-only view of the list, see List.Snapshot.
+func (sl *SortedList[T]) Snapshot() *Snapshot[T] {
+	return sl.list.Snapshot()
+}
+
+// Validate checks structural invariants of the list (see List.Validate),
+// that the tree of nodes holds list's nodes in order with their current
+// number of elements, and that elements are sorted.
+func (sl *SortedList[T]) Validate() error {
+	if err := sl.list.Validate(); err != nil {
+		return err
+	}
+
+	var (
+		node = sl.list.first
+		walk func(p *pnode[T]) bool
+	)
+
+	walk = func(p *pnode[T]) bool {
+		if p.leaf == nil {
+			return walk(p.left) && walk(p.right) &&
+				p.last == p.right.last && p.length == p.left.length+p.right.length
+		}
+
+		if p.leaf != node || p.length != node.size {
+			return false
+		}
+
+		node = node.next
+
+		return true
+	}
+
+	if !walk(sl.index) || node != nil {
+		return errors.New("Index of nodes does not match the list")
+	}
+
+	if !sl.list.IsSortedFunc(sl.cmp) {
+		return errors.New("Elements are not sorted")
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package goulist
+
+import (
+	"cmp"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSortedList_Insert(t *testing.T) {
+	var (
+		rnd  = rand.New(rand.NewSource(1))
+		sl   = NewSortedListCustomCap(nodeSize, cmp.Compare[int])
+		want = []int{}
+	)
+
+	for i := 0; i < 500; i++ {
+		v := rnd.Intn(100)
+
+		sl.Insert(v)
+
+		index, _ := slices.BinarySearch(want, v+1)
+		want = slices.Insert(want, index, v)
+
+		if i%3 == 0 {
+			v = rnd.Intn(100)
+			index, found := slices.BinarySearch(want, v)
+
+			if sl.Remove(v) != found {
+				t.Fatalf("SortedList.Remove(%d) = %v, want %v", v, !found, found)
+			}
+
+			if found {
+				want = slices.Delete(want, index, index+1)
+			}
+		}
+
+		if err := sl.Validate(); err != nil {
+			t.Fatalf("SortedList.Validate() error = %v", err)
+		}
+	}
+
+	if got := sl.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedList elements = %v, want %v", got, want)
+	}
+}
+
+func TestSortedList_InsertStable(t *testing.T) {
+	type pair struct {
+		key, seq int
+	}
+
+	sl := NewSortedListCustomCap(nodeSize, func(a, b pair) int {
+		return cmp.Compare(a.key, b.key)
+	})
+
+	for i := 0; i < 20; i++ {
+		sl.Insert(pair{i % 3, i})
+	}
+
+	prev := pair{-1, -1}
+
+	for p := range sl.Values() {
+		if p.key == prev.key && p.seq < prev.seq {
+			t.Fatalf("Equal elements %v and %v are out of insertion order", prev, p)
+		}
+
+		prev = p
+	}
+}
+
+func TestSortedList_Bounds(t *testing.T) {
+	// nodes are {1, 1, 2, 2} {2, 4, 4} {6, 6}
+	sl := NewSortedListCustomCap(nodeSize, cmp.Compare[int])
+	sl.InsertAll([]int{6, 2, 4, 1, 2, 6, 1, 4, 2})
+
+	tests := []struct {
+		name      string
+		val       int
+		wantLower int
+		wantUpper int
+		wantFound bool
+	}{
+		{"boundsLessTest", 0, 0, 0, false},
+		{"boundsFirstTest", 1, 0, 2, true},
+		{"boundsAcrossNodesTest", 2, 2, 5, true},
+		{"boundsMissingTest", 3, 5, 5, false},
+		{"boundsLastTest", 6, 7, 9, true},
+		{"boundsGreaterTest", 7, 9, 9, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sl.LowerBound(tt.val); got != tt.wantLower {
+				t.Errorf("SortedList.LowerBound() = %v, want %v", got, tt.wantLower)
+			}
+
+			if got := sl.UpperBound(tt.val); got != tt.wantUpper {
+				t.Errorf("SortedList.UpperBound() = %v, want %v", got, tt.wantUpper)
+			}
+
+			index, found := sl.Search(tt.val)
+
+			if index != tt.wantLower || found != tt.wantFound || sl.Contains(tt.val) != found {
+				t.Errorf("SortedList.Search() = %v, %v, want %v, %v", index, found, tt.wantLower, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestSortedList_Range(t *testing.T) {
+	sl := NewSortedListCustomCap(nodeSize, cmp.Compare[int])
+	sl.InsertAll([]int{6, 2, 4, 1, 2, 6, 1, 4, 2})
+
+	tests := []struct {
+		name   string
+		lo, hi int
+		want   []int
+	}{
+		{"rangeTest", 2, 6, []int{2, 2, 2, 4, 4}},
+		{"rangeAllTest", 0, 10, []int{1, 1, 2, 2, 2, 4, 4, 6, 6}},
+		{"rangeEmptyTest", 3, 4, nil},
+		{"rangeReversedTest", 6, 1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Collect(sl.Range(tt.lo, tt.hi)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SortedList.Range() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for v := range sl.Range(0, 10) {
+		if v > 1 {
+			break
+		}
+	}
+}
+
+func TestSortedUlist(t *testing.T) {
+	sl := NewSortedUlistCustomCap(nodeSize, func(a, b interface{}) int {
+		return cmp.Compare(a.(string), b.(string))
+	})
+
+	for _, s := range []string{"pear", "apple", "plum", "fig", "kiwi"} {
+		sl.Insert(s)
+	}
+
+	if v, _ := sl.PopFront(); v != "apple" {
+		t.Errorf("SortedUlist.PopFront() = %v, want apple", v)
+	}
+
+	if v, _ := sl.PopBack(); v != "plum" {
+		t.Errorf("SortedUlist.PopBack() = %v, want plum", v)
+	}
+
+	want := []interface{}{"fig", "kiwi", "pear"}
+
+	if got := sl.ExportElems(); !reflect.DeepEqual(got, want) || !sl.Contains("kiwi") {
+		t.Errorf("SortedUlist elements = %v, want %v", got, want)
+	}
+}
+
+func TestSortedList_RemoveAt(t *testing.T) {
+	var (
+		rnd  = rand.New(rand.NewSource(2))
+		sl   = NewSortedListCustomCap(nodeSize, cmp.Compare[int])
+		want = []int{}
+	)
+
+	for i := 0; i < 300; i++ {
+		v := rnd.Intn(1000)
+		sl.Insert(v)
+
+		index, _ := slices.BinarySearch(want, v+1)
+		want = slices.Insert(want, index, v)
+
+		switch i % 5 {
+		case 1:
+			index = rnd.Intn(len(want))
+
+			if got, err := sl.RemoveAt(index); err != nil || got != want[index] {
+				t.Fatalf("SortedList.RemoveAt(%d) = %v, %v, want %v", index, got, err, want[index])
+			}
+
+			want = slices.Delete(want, index, index+1)
+		case 2:
+			sl.PopFront()
+			want = want[1:]
+		case 3:
+			// change after snapshot copies nodes and rebuilds the index
+			snap := sl.Snapshot()
+			sl.PopBack()
+			want = want[:len(want)-1]
+
+			if snap.Len() != len(want)+1 {
+				t.Fatalf("SortedList.PopBack() changed snapshot")
+			}
+		}
+
+		if err := sl.Validate(); err != nil {
+			t.Fatalf("SortedList.Validate() error = %v", err)
+		}
+
+		if got, err := sl.At(len(want) / 2); err != nil || got != want[len(want)/2] {
+			t.Fatalf("SortedList.At() = %v, %v, want %v", got, err, want[len(want)/2])
+		}
+	}
+
+	if got := sl.ExportElems(); !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedList elements = %v, want %v", got, want)
+	}
+
+	if _, err := sl.RemoveAt(len(want)); err == nil {
+		t.Errorf("SortedList.RemoveAt() error = nil for index out of range")
+	}
+
+	sl.Clear()
+	sl.Insert(1)
+
+	if err := sl.Validate(); err != nil || sl.Len() != 1 {
+		t.Errorf("SortedList.Clear() broke list's structure: %v", err)
+	}
+}
+
+func TestSortedList_SearchCost(t *testing.T) {
+	calls := 0
+
+	sl := NewSortedListCustomCap(nodeSize, func(a, b int) int {
+		calls++
+		return cmp.Compare(a, b)
+	})
+
+	for i := 0; i < 10000; i++ {
+		sl.Insert(i)
+	}
+
+	calls = 0
+
+	// the tree of 5000 nodes is at most 18 levels high, plus search in node
+	if index, found := sl.Search(7777); index != 7777 || !found || calls > 25 {
+		t.Errorf("SortedList.Search() = %v, %v after %d comparisons", index, found, calls)
+	}
+}
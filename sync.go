@@ -0,0 +1,549 @@
+package goulist
+
+import (
+	"io"
+	"iter"
+	"sync"
+)
+
+// SyncList is a list safe for concurrent use by multiple goroutines.
+// It wraps List methods with reader/writer locking: methods that only read
+// the list may run concurrently, methods that change it are serialized.
+//
+// Each method is atomic on its own. Use View and Update to make several
+// operations atomic, for example to walk the list with its iterator:
+//
+// 	sl.Update(func(ul *List[int]) {
+// 		for it := ul.Iterator(); it.Next(); {
+// 			it.Set(it.Value() * 2)
+// 		}
+// 	})
+//
+// The list passed to View and Update, pointers passed to Do and slices
+// returned by View's list must not be retained after these calls return.
+type SyncList[T any] struct {
+	mu   sync.RWMutex
+	list *List[T]
+}
+
+// SyncUlist is a SyncList of interface{} elements.
+type SyncUlist = SyncList[interface{}]
+
+// NewSyncUlist creates new SyncUlist with node capacity CacheLineSize.
+// Options are applied as for NewUlist.
+func NewSyncUlist(opts ...Option) *SyncUlist {
+	return NewSyncList[interface{}](opts...)
+}
+
+// NewSyncUlistCustomCap creates new SyncUlist with node capacity c.
+// Options are applied as for NewUlistCustomCap.
+func NewSyncUlistCustomCap(c int, opts ...Option) *SyncUlist {
+	return NewSyncListCustomCap[interface{}](c, opts...)
+}
+
+// NewSyncList creates new SyncList of elements of type T with node capacity
+// CacheLineSize. Options are applied as for NewList.
+func NewSyncList[T any](opts ...Option) *SyncList[T] {
+	return &SyncList[T]{list: NewList[T](opts...)}
+}
+
+// NewSyncListCustomCap creates new SyncList of elements of type T with node
+// capacity c. Options are applied as for NewListCustomCap.
+func NewSyncListCustomCap[T any](c int, opts ...Option) *SyncList[T] {
+	return &SyncList[T]{list: NewListCustomCap[T](c, opts...)}
+}
+
+// View calls fn with the wrapped list under read lock. fn must not change
+// the list and must not call methods of sl.
+func (sl *SyncList[T]) View(fn func(*List[T])) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	fn(sl.list)
+}
+
+// Update calls fn with the wrapped list under write lock, so all changes
+// made by fn are seen by other goroutines at once. fn must not call
+// methods of sl.
+func (sl *SyncList[T]) Update(fn func(*List[T])) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	fn(sl.list)
+}
+
+// NodeCapacity returns max number of elements of each list's node.
+func (sl *SyncList[T]) NodeCapacity() int {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.NodeCapacity()
+}
+
+// GetSize returns number of list's nodes.
+func (sl *SyncList[T]) GetSize() int {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.GetSize()
+}
+
+// GetFirst returns slice filled with all list's first node elements.
+func (sl *SyncList[T]) GetFirst() []T {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.GetFirst()
+}
+
+// GetLast returns slice filled with all list's last node elements.
+func (sl *SyncList[T]) GetLast() []T {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.GetLast()
+}
+
+// Len returns number of list's elements.
+func (sl *SyncList[T]) Len() int {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Len()
+}
+
+// Push appends element val to the end of the list.
+func (sl *SyncList[T]) Push(val T) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.Push(val)
+}
+
+// PushAll appends all of the elements of the given slice vals to the end
+// of the list, in the original order.
+func (sl *SyncList[T]) PushAll(vals []T) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.PushAll(vals)
+}
+
+// PushAllFill appends elements of vals to the end of the list, filling
+// new nodes with fill elements each.
+func (sl *SyncList[T]) PushAllFill(vals []T, fill int) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.PushAllFill(vals, fill)
+}
+
+// Insert inserts element val to the node with index num.
+func (sl *SyncList[T]) Insert(val T, num int) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.Insert(val, num)
+}
+
+// InsertAll inserts elements of vals before element with the given
+// logical index.
+func (sl *SyncList[T]) InsertAll(index int, vals []T) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.InsertAll(index, vals)
+}
+
+// Get returns element with index elemNum from node with index nodeNum.
+func (sl *SyncList[T]) Get(nodeNum, elemNum int) (T, error) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Get(nodeNum, elemNum)
+}
+
+// Set replaces element with index elemNum in node with index nodeNum
+// with given element val.
+func (sl *SyncList[T]) Set(nodeNum, elemNum int, val T) (T, error) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.Set(nodeNum, elemNum, val)
+}
+
+// At returns element with the given logical index.
+func (sl *SyncList[T]) At(index int) (T, error) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.At(index)
+}
+
+// SetAt replaces element with the given logical index with given element val.
+func (sl *SyncList[T]) SetAt(index int, val T) (T, error) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.SetAt(index, val)
+}
+
+// InsertAt inserts a new element val before element with the given
+// logical index.
+func (sl *SyncList[T]) InsertAt(index int, val T) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.InsertAt(index, val)
+}
+
+// RemoveAt removes element with the given logical index from the list.
+func (sl *SyncList[T]) RemoveAt(index int) (T, error) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.RemoveAt(index)
+}
+
+// RemoveFromNode removes element with index elemNum from node with index nodeNum.
+func (sl *SyncList[T]) RemoveFromNode(nodeNum, elemNum int) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.RemoveFromNode(nodeNum, elemNum)
+}
+
+// RemoveAllOccurrences removes all occurrences of element val from the list.
+func (sl *SyncList[T]) RemoveAllOccurrences(val T) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	sl.list.RemoveAllOccurrences(val)
+}
+
+// RemoveAllOfSlice removes all occurrences of elements of vals from the list.
+func (sl *SyncList[T]) RemoveAllOfSlice(vals []T) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	sl.list.RemoveAllOfSlice(vals)
+}
+
+// PushFront inserts element val to the front of the list.
+func (sl *SyncList[T]) PushFront(val T) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.PushFront(val)
+}
+
+// PopFront removes and returns the first element of the list.
+func (sl *SyncList[T]) PopFront() (T, bool) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.PopFront()
+}
+
+// PopBack removes and returns the last element of the list.
+func (sl *SyncList[T]) PopBack() (T, bool) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.PopBack()
+}
+
+// PeekFront returns the first element of the list without removing it.
+func (sl *SyncList[T]) PeekFront() (T, bool) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.PeekFront()
+}
+
+// PeekBack returns the last element of the list without removing it.
+func (sl *SyncList[T]) PeekBack() (T, bool) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.PeekBack()
+}
+
+// Do calls function fn on each list's element under write lock, since fn
+// gets pointers into node storage and may change elements through them.
+// fn must not retain the pointers and must not call methods of sl.
+func (sl *SyncList[T]) Do(fn func(*T)) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	sl.list.Do(fn)
+}
+
+// Print prints each list's element.
+func (sl *SyncList[T]) Print() {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	sl.list.Print()
+}
+
+// Printc (Print custom) prints each list's element to given io.Writer w.
+func (sl *SyncList[T]) Printc(w io.Writer) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	sl.list.Printc(w)
+}
+
+// Clear removes all list's elements.
+func (sl *SyncList[T]) Clear() {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	sl.list.Clear()
+}
+
+// ExportElems returns slice filled with all list's elements.
+func (sl *SyncList[T]) ExportElems() []T {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.ExportElems()
+}
+
+// IsContains returns true if list contains at least one element val.
+func (sl *SyncList[T]) IsContains(val T) bool {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.IsContains(val)
+}
+
+// IsContainsAll returns true if list contains all of the elements
+// of the given slice.
+func (sl *SyncList[T]) IsContainsAll(vals []T) bool {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.IsContainsAll(vals)
+}
+
+// Values returns an iterator over snapshot of list's elements taken
+// on the first call of it. The lock is not held while loop body runs,
+// so the body may call methods of sl.
+func (sl *SyncList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range sl.ExportElems() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over indexes and elements of snapshot of the
+// list, as Values does.
+func (sl *SyncList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range sl.ExportElems() {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (sl *SyncList[T]) MarshalBinary() ([]byte, error) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (sl *SyncList[T]) UnmarshalBinary(data []byte) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.ensure().UnmarshalBinary(data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (sl *SyncList[T]) GobEncode() ([]byte, error) {
+	return sl.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (sl *SyncList[T]) GobDecode(data []byte) error {
+	return sl.UnmarshalBinary(data)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (sl *SyncList[T]) MarshalJSON() ([]byte, error) {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (sl *SyncList[T]) UnmarshalJSON(data []byte) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.ensure().UnmarshalJSON(data)
+}
+
+// ensure returns the wrapped list, creating it for zero SyncList,
+// which is decoded into. It must be called under write lock.
+func (sl *SyncList[T]) ensure() *List[T] {
+	if sl.list == nil {
+		sl.list = &List[T]{}
+	}
+
+	return sl.list
+}
+
+// Validate checks structural invariants of the list, see List.Validate.
+func (sl *SyncList[T]) Validate() error {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.Validate()
+}
+
+// SortFunc sorts list's elements as determined by function cmp,
+// see List.SortFunc.
+func (sl *SyncList[T]) SortFunc(cmp func(a, b T) int) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	sl.list.SortFunc(cmp)
+}
+
+// SortStableFunc sorts list's elements keeping the original order of equal
+// elements, see List.SortStableFunc.
+func (sl *SyncList[T]) SortStableFunc(cmp func(a, b T) int) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	sl.list.SortStableFunc(cmp)
+}
+
+// IsSortedFunc returns true if list's elements are sorted as determined
+// by function cmp.
+func (sl *SyncList[T]) IsSortedFunc(cmp func(a, b T) int) bool {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.IsSortedFunc(cmp)
+}
+
+// ContainsFunc returns true if list contains at least one element for which
+// pred returns true.
+func (sl *SyncList[T]) ContainsFunc(pred func(T) bool) bool {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.ContainsFunc(pred)
+}
+
+// IndexFunc returns logical index of the first element for which pred
+// returns true, or -1 if there is no such element.
+func (sl *SyncList[T]) IndexFunc(pred func(T) bool) int {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.IndexFunc(pred)
+}
+
+// RemoveFunc removes all elements for which pred returns true from the list.
+// Returns the number of removed elements.
+func (sl *SyncList[T]) RemoveFunc(pred func(T) bool) int {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.RemoveFunc(pred)
+}
+
+// RemoveAllOfSliceFunc removes all elements of the list, which are equal
+// to any element of given slice vals by given function eq.
+func (sl *SyncList[T]) RemoveAllOfSliceFunc(vals []T, eq func(a, b T) bool) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	sl.list.RemoveAllOfSliceFunc(vals, eq)
+}
+
+// IndexOf returns logical index of the first occurrence of element val,
+// or -1 if list does not contain it.
+func (sl *SyncList[T]) IndexOf(val T) int {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.IndexOf(val)
+}
+
+// LastIndexOf returns logical index of the last occurrence of element val,
+// or -1 if list does not contain it.
+func (sl *SyncList[T]) LastIndexOf(val T) int {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.LastIndexOf(val)
+}
+
+// FindAll returns logical indexes of all elements for which pred returns true.
+func (sl *SyncList[T]) FindAll(pred func(T) bool) []int {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.FindAll(pred)
+}
+
+// FindAllLocations returns locations of all elements for which pred
+// returns true.
+func (sl *SyncList[T]) FindAllLocations(pred func(T) bool) []Location {
+	sl.mu.RLock()
+	defer sl.mu.RUnlock()
+
+	return sl.list.FindAllLocations(pred)
+}
+
+// RetainFunc keeps only elements for which keep returns true and removes
+// the rest of them from the list. Returns the number of removed elements.
+func (sl *SyncList[T]) RetainFunc(keep func(T) bool) int {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.RetainFunc(keep)
+}
+
+// DeleteFunc removes all elements for which del returns true from the list.
+// Returns the number of removed elements.
+func (sl *SyncList[T]) DeleteFunc(del func(T) bool) int {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.DeleteFunc(del)
+}
+
+// Concat moves all of the elements of the list other to the end of the list.
+// The list other becomes empty, it must not be used concurrently.
+func (sl *SyncList[T]) Concat(other *List[T]) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.Concat(other)
+}
+
+// Splice moves all of the elements of the list other into the list before
+// element with the given logical index. The list other becomes empty,
+// it must not be used concurrently.
+func (sl *SyncList[T]) Splice(index int, other *List[T]) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	return sl.list.Splice(index, other)
+}
@@ -0,0 +1,263 @@
+package goulist
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestSyncList_Methods(t *testing.T) {
+	sl := NewSyncListCustomCap[int](nodeSize)
+
+	sl.PushAll([]int{3, 4, 5})
+	sl.PushFront(1)
+	sl.InsertAt(1, 2)
+	sl.Push(6)
+	sl.SetAt(0, 0)
+
+	if got, want := sl.ExportElems(), []int{0, 2, 3, 4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("SyncList elements = %v, want %v", got, want)
+	}
+
+	if v, ok := sl.PopBack(); !ok || v != 6 {
+		t.Errorf("SyncList.PopBack() = %v, %v, want 6", v, ok)
+	}
+
+	if v, err := sl.RemoveAt(0); err != nil || v != 0 {
+		t.Errorf("SyncList.RemoveAt() = %v, %v, want 0", v, err)
+	}
+
+	sl.RemoveAllOccurrences(3)
+
+	if !sl.IsContainsAll([]int{2, 4, 5}) || sl.IsContains(3) || sl.Len() != 3 {
+		t.Errorf("SyncList elements = %v, want [2 4 5]", sl.ExportElems())
+	}
+
+	got := []int{}
+
+	for i, v := range sl.All() {
+		if i != len(got) {
+			t.Errorf("SyncList.All() index = %d, want %d", i, len(got))
+		}
+
+		// the lock is not held by loop body
+		sl.Push(v * 10)
+		got = append(got, v)
+	}
+
+	if want := []int{2, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SyncList.All() = %v, want %v", got, want)
+	}
+
+	if sl.Len() != 6 {
+		t.Errorf("SyncList.Len() = %d, want 6", sl.Len())
+	}
+
+	if err := sl.Validate(); err != nil {
+		t.Errorf("SyncList structure is broken: %v", err)
+	}
+
+	var buf bytes.Buffer
+
+	sl.Clear()
+	sl.Push(7)
+	sl.Printc(&buf)
+
+	if buf.String() != "7\n" {
+		t.Errorf("SyncList.Printc() = %q, want %q", buf.String(), "7\n")
+	}
+
+	if err := sl.Validate(); err != nil {
+		t.Errorf("SyncList.Clear() broke list's structure: %v", err)
+	}
+}
+
+func TestSyncList_Encoding(t *testing.T) {
+	sl := NewSyncListCustomCap[int](nodeSize)
+	sl.PushAll([]int{1, 2, 3, 4, 5})
+
+	data, err := json.Marshal(sl)
+
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var dst SyncList[int]
+
+	if err := json.Unmarshal(data, &dst); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if got := dst.ExportElems(); !reflect.DeepEqual(got, sl.ExportElems()) {
+		t.Errorf("Decoded elements = %v, want %v", got, sl.ExportElems())
+	}
+
+	if err := dst.Validate(); err != nil {
+		t.Errorf("json.Unmarshal() broke list's structure: %v", err)
+	}
+
+	bin, err := sl.MarshalBinary()
+
+	if err != nil {
+		t.Fatalf("SyncList.MarshalBinary() error = %v", err)
+	}
+
+	var bdst SyncList[int]
+
+	if err := bdst.UnmarshalBinary(bin); err != nil {
+		t.Fatalf("SyncList.UnmarshalBinary() error = %v", err)
+	}
+
+	sl.View(func(want *List[int]) {
+		bdst.View(func(got *List[int]) {
+			checkSameLayout(t, got, want)
+		})
+	})
+}
+
+func TestSyncList_Update(t *testing.T) {
+	const (
+		workers = 8
+		rounds  = 200
+	)
+
+	sl := NewSyncUlistCustomCap(nodeSize)
+	sl.Push(0)
+
+	var wg sync.WaitGroup
+
+	// read-modify-write of the last element is atomic only under Update
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < rounds; i++ {
+				sl.Update(func(ul *Ulist) {
+					v, _ := ul.PeekBack()
+					ul.Push(v.(int) + 1)
+				})
+			}
+		}()
+	}
+
+	// readers must never see a gap in the sequence
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < rounds/10; i++ {
+				sl.View(func(ul *Ulist) {
+					for i, v := range ul.All() {
+						if v != i {
+							t.Errorf("SyncUlist element %d = %v", i, v)
+							return
+						}
+					}
+				})
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got, want := sl.Len(), workers*rounds+1; got != want {
+		t.Errorf("SyncUlist.Len() = %d, want %d", got, want)
+	}
+
+	if err := sl.Validate(); err != nil {
+		t.Errorf("SyncUlist.Update() broke list's structure: %v", err)
+	}
+}
+
+func TestSyncList_Concurrent(t *testing.T) {
+	const (
+		workers = 8
+		rounds  = 500
+	)
+
+	sl := NewSyncListCustomCap[int](nodeSize)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < rounds; i++ {
+				v := w*rounds + i
+
+				switch i % 5 {
+				case 0:
+					sl.PushFront(v)
+				case 1:
+					sl.InsertAt(sl.Len()/2, v)
+				default:
+					sl.Push(v)
+				}
+
+				// readers and mutators racing on the same list
+				sl.At(i % sl.Len())
+				sl.IsContains(v)
+				sl.PeekFront()
+				sl.GetLast()
+
+				if i%50 == 0 {
+					sl.Do(func(p *int) {})
+					sl.MarshalJSON()
+				}
+			}
+		}(w)
+	}
+
+	wg.Wait()
+
+	got := sl.ExportElems()
+	sort.Ints(got)
+
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("SyncList lost or duplicated elements: got %d at %d", v, i)
+		}
+	}
+
+	if len(got) != workers*rounds {
+		t.Errorf("SyncList.Len() = %d, want %d", len(got), workers*rounds)
+	}
+
+	if err := sl.Validate(); err != nil {
+		t.Errorf("Concurrent changes broke list's structure: %v", err)
+	}
+
+	// remove everything concurrently
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				if _, ok := sl.PopFront(); !ok {
+					return
+				}
+
+				sl.PopBack()
+				sl.Len()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := sl.Validate(); err != nil || sl.Len() != 0 || sl.GetSize() != 1 {
+		t.Errorf("SyncList is not empty after removing of all elements: %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package goulist
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Validate checks structural invariants of the list. It walks the list
+// forwards and backwards and returns error describing the first broken
+// invariant, or nil if the list is consistent:
+//
+// 	- prev and next links of neighbour nodes point to each other, the first
+// 	  node has no previous node and the last one is the tail of the list;
+// 	- number of nodes and of elements match GetSize() and Len();
+// 	- all nodes have the same capacity and policy (compared by value, see
+// 	  Concat), size of each node is in range of its capacity and slots
+// 	  after the last element are empty;
+// 	- no node is empty, unless the list has only one node, and no node but
+// 	  the last one is filled less than min fill (see WithMinFill).
+//
+// It is meant for tests and debugging, it takes O(n) time.
+func (ul *List[T]) Validate() error {
+	if ul.first == nil || ul.last == nil {
+		return errors.New("List has no nodes")
+	}
+
+	if ul.first.prev != nil {
+		return errors.New("First node has link to previous node")
+	}
+
+	var (
+		tail   *ulistNode[T]
+		nodes  = 0
+		length = 0
+	)
+
+	// links are checked on the way, so broken list can not loop
+	for node := ul.first; node != nil; node = node.next {
+		if err := ul.validateNode(node, nodes); err != nil {
+			return err
+		}
+
+		tail = node
+		nodes++
+		length += node.size
+	}
+
+	if tail != ul.last {
+		return errors.New("Last node is not the tail of the list")
+	}
+
+	if nodes != ul.size {
+		return fmt.Errorf("Number of nodes %d does not match list's size %d", nodes, ul.size)
+	}
+
+	if length != ul.length {
+		return fmt.Errorf("Number of elements %d does not match list's length %d", length, ul.length)
+	}
+
+	back := 0
+
+	for node := ul.last; node != nil; node = node.prev {
+		if node.prev != nil && node.prev.next != node {
+			return fmt.Errorf("Node %d: previous node does not link to it", nodes-1-back)
+		}
+
+		back++
+	}
+
+	if back != nodes {
+		return fmt.Errorf("Backward walk found %d nodes, forward walk found %d", back, nodes)
+	}
+
+	return nil
+}
+
+// validateNode checks invariants of the node with index i of the list.
+func (ul *List[T]) validateNode(node *ulistNode[T], i int) error {
+	if node.next != nil && node.next.prev != node {
+		return fmt.Errorf("Node %d: next node does not link back to it", i)
+	}
+
+	if node.capacity != ul.first.capacity || len(node.elems) != node.capacity {
+		return fmt.Errorf("Node %d: capacity %d with %d slots, want %d",
+			i, node.capacity, len(node.elems), ul.first.capacity)
+	}
+
+	if !node.samePolicy(ul.first) {
+		return fmt.Errorf("Node %d: policy differs from policy of the first node", i)
+	}
+
+	if node.size < 0 || node.size > node.capacity {
+		return fmt.Errorf("Node %d: size %d is out of range [0, %d]", i, node.size, node.capacity)
+	}
+
+	for j := node.size; j < node.capacity; j++ {
+		if !reflect.ValueOf(&node.elems[j]).Elem().IsZero() {
+			return fmt.Errorf("Node %d: size %d does not match occupied slots, slot %d is not empty",
+				i, node.size, j)
+		}
+	}
+
+	if node.size == 0 && (node != ul.first || node.next != nil) {
+		return fmt.Errorf("Node %d is empty", i)
+	}
+
+	if m := node.minFill(); node.next != nil && node.size < m {
+		return fmt.Errorf("Node %d: size %d is less than min fill %d", i, node.size, m)
+	}
+
+	return nil
+}
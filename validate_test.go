@@ -0,0 +1,267 @@
+package goulist
+
+import (
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestList_Validate(t *testing.T) {
+	// nodes are {0, 1} {2, 3} {4, 5, 6}
+	newList := func() *List[int] {
+		return newRangeList(nodeSize, 0, 7)
+	}
+
+	tests := []struct {
+		name    string
+		corrupt func(ul *List[int])
+		wantErr string
+	}{
+		{
+			"validateTest",
+			func(ul *List[int]) {},
+			"",
+		},
+
+		{
+			"validateFirstPrevTest",
+			func(ul *List[int]) { ul.first.prev = ul.last },
+			"First node has link to previous node",
+		},
+
+		{
+			"validateNextPrevTest",
+			func(ul *List[int]) { ul.first.next.prev = nil },
+			"Node 0: next node does not link back to it",
+		},
+
+		{
+			"validateLastTest",
+			func(ul *List[int]) { ul.last = ul.last.prev },
+			"Last node is not the tail of the list",
+		},
+
+		{
+			"validateSizeTest",
+			func(ul *List[int]) { ul.size++ },
+			"Number of nodes 3 does not match list's size 4",
+		},
+
+		{
+			"validateLengthTest",
+			func(ul *List[int]) { ul.length-- },
+			"Number of elements 7 does not match list's length 6",
+		},
+
+		{
+			"validatePolicyTest",
+			func(ul *List[int]) { ul.last.opts = newOptions(nodeSize, 8, []Option{WithMinFill(1)}) },
+			"Node 2: policy differs from policy of the first node",
+		},
+
+		{
+			"validateSamePolicyTest",
+			func(ul *List[int]) { ul.last.opts = newOptions(nodeSize, 8, []Option{WithMinFill(2)}) },
+			"",
+		},
+
+		{
+			"validateNodeSizeTest",
+			func(ul *List[int]) { ul.first.size = 5 },
+			"Node 0: size 5 is out of range [0, 4]",
+		},
+
+		{
+			"validateOccupiedTest",
+			func(ul *List[int]) {
+				ul.first.size--
+				ul.length--
+			},
+			"Node 0: size 1 does not match occupied slots, slot 1 is not empty",
+		},
+
+		{
+			"validateCapacityTest",
+			func(ul *List[int]) { ul.last.elems = ul.last.elems[:2] },
+			"Node 2: capacity 4 with 2 slots, want 4",
+		},
+
+		{
+			"validateMinFillTest",
+			func(ul *List[int]) {
+				ul.first.next.elems[1] = 0
+				ul.first.next.size--
+				ul.length--
+			},
+			"Node 1: size 1 is less than min fill 2",
+		},
+
+		{
+			"validateEmptyTest",
+			func(ul *List[int]) {
+				ul.last.elems[0], ul.last.elems[1], ul.last.elems[2] = 0, 0, 0
+				ul.last.size = 0
+				ul.length -= 3
+			},
+			"Node 2 is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ul := newList()
+			tt.corrupt(ul)
+
+			err := ul.Validate()
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("List.Validate() error = %v", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("List.Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestList_ValidateMutations checks invariants after every mutation of lists
+// with different policies, comparing them with a slice.
+func TestList_ValidateMutations(t *testing.T) {
+	policies := []struct {
+		name string
+		opts []Option
+	}{
+		{"defaultPolicyTest", nil},
+		{"minFillPolicyTest", []Option{WithMinFill(1)}},
+		{"splitRatioPolicyTest", []Option{WithSplitRatio(0.75)}},
+		{"appendSplitPolicyTest", []Option{WithAppendOptimizedSplit()}},
+	}
+
+	for _, p := range policies {
+		t.Run(p.name, func(t *testing.T) {
+			var (
+				ul   = NewListCustomCap[int](nodeSize, p.opts...)
+				want = []int{}
+				rnd  = rand.New(rand.NewSource(1))
+			)
+
+			for i := 0; i < 3000; i++ {
+				op := rnd.Intn(14)
+				index := rnd.Intn(len(want) + 1)
+
+				switch op {
+				case 0:
+					ul.Push(i)
+					want = append(want, i)
+				case 1:
+					ul.PushFront(i)
+					want = append([]int{i}, want...)
+				case 2, 3:
+					ul.InsertAt(index, i)
+					want = append(want[:index], append([]int{i}, want[index:]...)...)
+				case 4:
+					vals := []int{i, i + 1, i + 2}
+					ul.InsertAll(index, vals)
+					want = append(want[:index], append(vals, want[index:]...)...)
+				case 5:
+					vals := []int{i, i + 1, i + 2, i + 3, i + 4}
+					ul.PushAll(vals)
+					want = append(want, vals...)
+				case 6, 7:
+					if index < len(want) {
+						ul.RemoveAt(index)
+						want = append(want[:index], want[index+1:]...)
+					}
+				case 8:
+					if _, ok := ul.PopFront(); ok {
+						want = want[1:]
+					}
+				case 9:
+					if _, ok := ul.PopBack(); ok {
+						want = want[:len(want)-1]
+					}
+				case 10:
+					// remove elements divisible by 7
+					ul.Do(func(p *int) {
+						if *p%7 == 0 {
+							*p = -7
+						}
+					})
+
+					ul.RemoveAllOccurrences(-7)
+
+					k := 0
+
+					for _, v := range want {
+						if v%7 != 0 {
+							want[k] = v
+							k++
+						}
+					}
+
+					want = want[:k]
+				case 11:
+					it := ul.Iterator()
+
+					if it.Seek(index) == nil {
+						it.InsertAfter(i)
+						it.Remove()
+						want[index] = i
+					}
+				case 12:
+					if len(want) > 100 {
+						ul.Clear()
+						want = want[:0]
+					}
+				case 13:
+					ul.Snapshot()
+					ul.SetAt(0, i)
+
+					if len(want) > 0 {
+						want[0] = i
+					}
+				}
+
+				if err := ul.Validate(); err != nil {
+					t.Fatalf("Operation %d of step %d broke the list: %v", op, i, err)
+				}
+			}
+
+			if got := ul.ExportElems(); !reflect.DeepEqual(got, want) {
+				t.Errorf("List elements = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSyncList_Validate(t *testing.T) {
+	var (
+		sl = NewSyncListCustomCap[int](nodeSize)
+		wg sync.WaitGroup
+	)
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 100; i++ {
+				sl.InsertAt(sl.Len()/2, i)
+
+				if err := sl.Validate(); err != nil {
+					t.Errorf("SyncList.Validate() error = %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}